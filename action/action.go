@@ -90,8 +90,15 @@ func Verify(sealed SealedEnvelope) error {
 		return errors.Wrap(ErrInsufficientBalanceForGas, "insufficient gas")
 	}
 
-	hash := sealed.Envelope.Hash()
-	if sealed.SrcPubkey().Verify(hash[:], sealed.Signature()) {
+	hash, sig := sealed.Envelope.Hash(), sealed.Signature()
+	if sealed.Encoding() == EthereumRLP {
+		// Ethereum transaction is signed over the RLP-encoded transaction with EIP-155 signer
+		if hash, err = sealed.ethSigningHash(); err != nil {
+			return errors.Wrap(ErrAction, err.Error())
+		}
+		sig = sealed.ethSignature()
+	}
+	if sealed.SrcPubkey().Verify(hash[:], sig) {
 		return nil
 	}
 	return errors.Wrapf(
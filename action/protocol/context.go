@@ -11,6 +11,7 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-address/address"
 	"github.com/iotexproject/iotex-core/blockchain/genesis"
@@ -26,6 +27,8 @@ type (
 
 	registryContextKey struct{}
 
+	vmConfigContextKey struct{}
+
 	// TipInfo contains the tip block information
 	TipInfo struct {
 		Height    uint64
@@ -150,3 +153,14 @@ func MustGetActionCtx(ctx context.Context) ActionCtx {
 	}
 	return ac
 }
+
+// WithVMConfigCtx adds vm config into context, which is used to trace the execution in EVM
+func WithVMConfigCtx(ctx context.Context, vmConfig vm.Config) context.Context {
+	return context.WithValue(ctx, vmConfigContextKey{}, vmConfig)
+}
+
+// GetVMConfigCtx gets vm config from context
+func GetVMConfigCtx(ctx context.Context) (vm.Config, bool) {
+	cfg, ok := ctx.Value(vmConfigContextKey{}).(vm.Config)
+	return cfg, ok
+}
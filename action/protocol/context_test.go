@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-address/address"
 	"github.com/stretchr/testify/require"
@@ -165,3 +166,15 @@ func TestMustGetActionCtx(t *testing.T) {
 	// Case II: Panic
 	require.Panics(func() { MustGetActionCtx(context.Background()) }, "Miss action context")
 }
+
+func TestGetVMConfigCtx(t *testing.T) {
+	require := require.New(t)
+	_, ok := GetVMConfigCtx(context.Background())
+	require.False(ok)
+	tracer := vm.NewStructLogger(nil)
+	ctx := WithVMConfigCtx(context.Background(), vm.Config{Debug: true, Tracer: tracer})
+	cfg, ok := GetVMConfigCtx(ctx)
+	require.True(ok)
+	require.True(cfg.Debug)
+	require.Equal(tracer, cfg.Tracer)
+}
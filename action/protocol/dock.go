@@ -22,7 +22,7 @@ type dock struct {
 }
 
 // NewDock returns a new dock
-func NewDock() Dock {
+func NewDock() CloneableDock {
 	return &dock{
 		stash: map[string]map[string][]byte{},
 	}
@@ -63,3 +63,17 @@ func (d *dock) Reset() {
 		delete(d.stash, k)
 	}
 }
+
+// Clone returns a copy of the dock, the serialized data are shared as they are replaced rather than modified in Load()
+func (d *dock) Clone() CloneableDock {
+	stash := make(map[string]map[string][]byte, len(d.stash))
+	for ns, kv := range d.stash {
+		stash[ns] = make(map[string][]byte, len(kv))
+		for k, v := range kv {
+			stash[ns][k] = v
+		}
+	}
+	return &dock{
+		stash: stash,
+	}
+}
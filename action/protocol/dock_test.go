@@ -79,7 +79,19 @@ func TestDock(t *testing.T) {
 	r.NoError(dk.Unload("as", "test6", ts))
 	r.Equal(v6, ts)
 
+	// the clone is not affected by the changes of dock, and vice versa
+	clone := dk.Clone()
+	r.NoError(dk.Load("as", "test6", v5))
+	r.NoError(dk.Load("bs", "test7", v5))
+	r.NoError(clone.Unload("as", "test6", ts))
+	r.Equal(v6, ts)
+	r.False(clone.ProtocolDirty("bs"))
+	r.NoError(clone.Load("as", "test6", &testString{"v7"}))
+	r.NoError(dk.Unload("as", "test6", ts))
+	r.Equal(v5, ts)
+
 	dk.Reset()
+	r.True(clone.ProtocolDirty("as"))
 	for _, e := range testDocks {
 		r.False(dk.ProtocolDirty(e.name))
 	}
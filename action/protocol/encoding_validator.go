@@ -0,0 +1,58 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package protocol
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/config"
+)
+
+// EncodingValidator is the validator of the encoding of the content signed by the sender
+type EncodingValidator struct {
+	sr           StateReader
+	hu           config.HeightUpgrade
+	evmNetworkID uint32
+}
+
+// NewEncodingValidator constructs a new encoding validator
+func NewEncodingValidator(sr StateReader, g genesis.Genesis) *EncodingValidator {
+	return &EncodingValidator{
+		sr:           sr,
+		hu:           config.NewHeightUpgrade(&g),
+		evmNetworkID: g.EVMNetworkID,
+	}
+}
+
+// Validate rejects Ethereum transaction before Iceland height, or signed for another EVM network
+func (v *EncodingValidator) Validate(ctx context.Context, selp action.SealedEnvelope) error {
+	if selp.Encoding() != action.EthereumRLP {
+		return nil
+	}
+	// the action is validated in the block, otherwise it is going to be packed into next block
+	var height uint64
+	if blkCtx, ok := GetBlockCtx(ctx); ok {
+		height = blkCtx.BlockHeight
+	} else {
+		tip, err := v.sr.Height()
+		if err != nil {
+			return err
+		}
+		height = tip + 1
+	}
+	if v.hu.IsPre(config.Iceland, height) {
+		return errors.Wrapf(action.ErrAction, "Ethereum transaction is not accepted before height %d", v.hu.IcelandBlockHeight())
+	}
+	if selp.EVMNetworkID() != v.evmNetworkID {
+		return errors.Wrapf(action.ErrAction, "EVM network ID %d does not match %d", selp.EVMNetworkID(), v.evmNetworkID)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package protocol
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+type tipReader struct {
+	StateReader
+	height uint64
+}
+
+func (r *tipReader) Height() (uint64, error) { return r.height, nil }
+
+func TestEncodingValidator(t *testing.T) {
+	require := require.New(t)
+
+	g := config.Default.Genesis
+	g.IcelandBlockHeight = 10
+	g.EVMNetworkID = 4690
+	const evmNetworkID = 4690
+	sr := &tipReader{height: 8}
+	v := NewEncodingValidator(sr, g)
+	rawTx := func(chainID uint32) action.SealedEnvelope {
+		raw, err := testutil.SignedRawTx(identityset.Address(28).String(), identityset.PrivateKey(27), 1, big.NewInt(10), 21000, big.NewInt(0), nil, chainID)
+		require.NoError(err)
+		selp, err := action.DecodeRawTx(raw, chainID)
+		require.NoError(err)
+		return selp
+	}
+	selp := rawTx(evmNetworkID)
+
+	// the Ethereum transaction is rejected before Iceland height
+	require.Equal(action.ErrAction, errors.Cause(v.Validate(context.Background(), selp)))
+	require.Equal(action.ErrAction, errors.Cause(v.Validate(WithBlockCtx(context.Background(), BlockCtx{BlockHeight: 9}), selp)))
+	sr.height = 9
+	require.NoError(v.Validate(context.Background(), selp))
+	require.NoError(v.Validate(WithBlockCtx(context.Background(), BlockCtx{BlockHeight: 10}), selp))
+
+	// the Ethereum transaction signed for another network is rejected
+	for _, chainID := range []uint32{1, evmNetworkID + 1} {
+		require.Equal(action.ErrAction, errors.Cause(v.Validate(context.Background(), rawTx(chainID))))
+	}
+
+	// the IoTeX action is not affected
+	tsf, err := testutil.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), 1, big.NewInt(10), nil, 21000, big.NewInt(0))
+	require.NoError(err)
+	sr.height = 0
+	require.NoError(v.Validate(context.Background(), tsf))
+}
@@ -0,0 +1,234 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package evm
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	// errExecutionReverted is the error message of execution reverted in EVM
+	errExecutionReverted = "evm: execution reverted"
+	// errInternalFailure is the error of an internal call which returns 0 without reverting
+	errInternalFailure = "internal failure"
+)
+
+type (
+	// CallFrame is a call or create in the call tree of an execution
+	CallFrame struct {
+		Type         string
+		From         common.Address
+		To           common.Address
+		Value        *big.Int
+		Gas          uint64
+		GasUsed      uint64
+		Input        []byte
+		Output       []byte
+		Error        string
+		RevertReason string
+		Calls        []*CallFrame
+
+		// gas before and cost of the opcode which starts the call, and the memory to store the return data
+		gasIn   uint64
+		gasCost uint64
+		outOff  int64
+		outLen  int64
+		entered bool
+	}
+
+	// CallTracer is a vm.Tracer which builds the call tree of an execution, including the internal calls, creates
+	// and self-destructs
+	CallTracer struct {
+		callstack []*CallFrame
+		// descended indicates the last opcode starts a call
+		descended bool
+	}
+)
+
+// NewCallTracer creates a call tracer
+func NewCallTracer() *CallTracer {
+	return &CallTracer{}
+}
+
+// Result returns the outermost call frame, or nil if the execution has not started
+func (t *CallTracer) Result() *CallFrame {
+	if len(t.callstack) == 0 {
+		return nil
+	}
+	return t.callstack[0]
+}
+
+// CaptureStart starts the outermost call frame
+func (t *CallTracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	typ := vm.CALL.String()
+	if create {
+		typ = vm.CREATE.String()
+	}
+	t.callstack = []*CallFrame{{
+		Type:    typ,
+		From:    from,
+		To:      to,
+		Value:   new(big.Int).Set(value),
+		Gas:     gas,
+		Input:   common.CopyBytes(input),
+		entered: true,
+	}}
+	return nil
+}
+
+// CaptureState tracks the calls from the opcodes and the change of depth
+func (t *CallTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	if err != nil {
+		t.fault(err)
+		return nil
+	}
+	if len(t.callstack) == 0 {
+		return nil
+	}
+	switch op {
+	case vm.CREATE, vm.CREATE2:
+		t.callstack = append(t.callstack, &CallFrame{
+			Type:    op.String(),
+			From:    contract.Address(),
+			Value:   new(big.Int).Set(stack.Back(0)),
+			Input:   memory.Get(stack.Back(1).Int64(), stack.Back(2).Int64()),
+			gasIn:   gas,
+			gasCost: cost,
+		})
+		t.descended = true
+		return nil
+	case vm.SELFDESTRUCT:
+		top := t.callstack[len(t.callstack)-1]
+		top.Calls = append(top.Calls, &CallFrame{
+			Type:    op.String(),
+			From:    contract.Address(),
+			To:      common.BigToAddress(stack.Back(0)),
+			Value:   env.StateDB.GetBalance(contract.Address()),
+			GasUsed: cost,
+		})
+		return nil
+	case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL:
+		// CALL and CALLCODE have the value in the 3rd item of stack
+		off := 0
+		value := big.NewInt(0)
+		if op == vm.CALL || op == vm.CALLCODE {
+			off = 1
+			value = new(big.Int).Set(stack.Back(2))
+		}
+		t.callstack = append(t.callstack, &CallFrame{
+			Type:    op.String(),
+			From:    contract.Address(),
+			To:      common.BigToAddress(stack.Back(1)),
+			Value:   value,
+			Input:   memory.Get(stack.Back(2+off).Int64(), stack.Back(3+off).Int64()),
+			gasIn:   gas,
+			gasCost: cost,
+			outOff:  stack.Back(4 + off).Int64(),
+			outLen:  stack.Back(5 + off).Int64(),
+		})
+		t.descended = true
+		return nil
+	}
+	if t.descended {
+		// the first opcode after a call is in the callee if the call has entered the code of the callee
+		if depth >= len(t.callstack) {
+			top := t.callstack[len(t.callstack)-1]
+			top.Gas = gas
+			top.entered = true
+		}
+		t.descended = false
+	}
+	if op == vm.REVERT {
+		t.callstack[len(t.callstack)-1].Error = errExecutionReverted
+		return nil
+	}
+	if depth != len(t.callstack)-1 {
+		return nil
+	}
+	// back to the caller, the call at the top of stack has returned
+	call := t.callstack[len(t.callstack)-1]
+	t.callstack = t.callstack[:len(t.callstack)-1]
+	ret := stack.Back(0)
+	switch call.Type {
+	case vm.CREATE.String(), vm.CREATE2.String():
+		call.GasUsed = call.gasIn - call.gasCost - gas
+		if ret.Sign() != 0 {
+			call.To = common.BigToAddress(ret)
+			call.Output = env.StateDB.GetCode(call.To)
+		} else if call.Error == "" {
+			call.Error = errInternalFailure
+		}
+	default:
+		if call.entered {
+			call.GasUsed = call.gasIn - call.gasCost + call.Gas - gas
+		}
+		if ret.Sign() != 0 || call.Error != "" {
+			call.Output = memory.Get(call.outOff, call.outLen)
+		} else {
+			call.Error = errInternalFailure
+		}
+	}
+	t.endCall(call)
+	return nil
+}
+
+// CaptureFault ends the call in which the opcode fails
+func (t *CallTracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	t.fault(err)
+	return nil
+}
+
+// CaptureEnd ends the outermost call frame
+func (t *CallTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) error {
+	root := t.Result()
+	if root == nil {
+		return nil
+	}
+	root.GasUsed = gasUsed
+	root.Output = common.CopyBytes(output)
+	if err != nil {
+		root.Error = err.Error()
+		if err.Error() == errExecutionReverted {
+			root.RevertReason, _ = DecodeRevertReason(output)
+		}
+	}
+	return nil
+}
+
+func (t *CallTracer) fault(err error) {
+	if len(t.callstack) == 0 {
+		return
+	}
+	top := t.callstack[len(t.callstack)-1]
+	if top.Error != "" {
+		// the call has been reverted
+		return
+	}
+	t.callstack = t.callstack[:len(t.callstack)-1]
+	top.Error = err.Error()
+	if top.entered {
+		top.GasUsed = top.Gas
+	}
+	if len(t.callstack) == 0 {
+		// keep the outermost call frame
+		t.callstack = append(t.callstack, top)
+		return
+	}
+	t.endCall(top)
+}
+
+func (t *CallTracer) endCall(call *CallFrame) {
+	if call.Error == errExecutionReverted {
+		call.RevertReason, _ = DecodeRevertReason(call.Output)
+	}
+	parent := t.callstack[len(t.callstack)-1]
+	parent.Calls = append(parent.Calls, call)
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package evm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	ethstate "github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/config"
+)
+
+func TestCallTracer(t *testing.T) {
+	require := require.New(t)
+
+	payload := revertPayload("oops")
+	// callee copies the revert payload appended to its code into memory, and reverts with it
+	callee := common.BytesToAddress([]byte("callee"))
+	calleeCode := append([]byte{
+		byte(vm.PUSH1), byte(len(payload)), byte(vm.PUSH1), 12, byte(vm.PUSH1), 0, byte(vm.CODECOPY),
+		byte(vm.PUSH1), byte(len(payload)), byte(vm.PUSH1), 0, byte(vm.REVERT),
+	}, payload...)
+	// caller calls callee with all gas, and stores the return data at memory 0
+	callerCode := []byte{
+		byte(vm.PUSH1), byte(len(payload)), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0,
+		byte(vm.PUSH20),
+	}
+	callerCode = append(callerCode, callee.Bytes()...)
+	callerCode = append(callerCode, byte(vm.GAS), byte(vm.CALL), byte(vm.POP), byte(vm.STOP))
+
+	sdb, err := ethstate.New(common.Hash{}, ethstate.NewDatabase(rawdb.NewMemoryDatabase()))
+	require.NoError(err)
+	sdb.CreateAccount(callee)
+	sdb.SetCode(callee, calleeCode)
+
+	hu := config.NewHeightUpgrade(&config.Default.Genesis)
+	tracer := NewCallTracer()
+	require.Nil(tracer.Result())
+	_, _, err = runtime.Execute(callerCode, []byte{1, 2}, &runtime.Config{
+		ChainConfig: getChainConfig(hu),
+		BlockNumber: new(big.Int).SetUint64(hu.GreenlandBlockHeight()),
+		GasLimit:    1000000,
+		State:       sdb,
+		EVMConfig: vm.Config{
+			Debug:  true,
+			Tracer: tracer,
+		},
+	})
+	require.NoError(err)
+
+	root := tracer.Result()
+	require.NotNil(root)
+	require.Equal("CALL", root.Type)
+	require.Equal(common.BytesToAddress([]byte("contract")), root.To)
+	require.Equal([]byte{1, 2}, root.Input)
+	require.Equal(uint64(1000000), root.Gas)
+	require.NotZero(root.GasUsed)
+	require.Empty(root.Error)
+	require.Len(root.Calls, 1)
+
+	call := root.Calls[0]
+	require.Equal("CALL", call.Type)
+	require.Equal(root.To, call.From)
+	require.Equal(callee, call.To)
+	require.Zero(call.Value.Sign())
+	require.Empty(call.Input)
+	require.NotZero(call.Gas)
+	require.NotZero(call.GasUsed)
+	require.True(call.GasUsed < call.Gas)
+	require.Equal(payload, call.Output)
+	require.Equal(errExecutionReverted, call.Error)
+	require.Equal("oops", call.RevertReason)
+	require.Empty(call.Calls)
+	require.True(root.GasUsed > call.GasUsed)
+}
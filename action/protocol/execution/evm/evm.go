@@ -191,7 +191,8 @@ func ExecuteContract(
 	if err != nil {
 		return nil, nil, err
 	}
-	retval, depositGas, remainingGas, contractAddress, statusCode, err := executeInEVM(ps, stateDB, hu, blkCtx.GasLimit, blkCtx.BlockHeight)
+	vmConfig, _ := protocol.GetVMConfigCtx(ctx)
+	retval, depositGas, remainingGas, contractAddress, statusCode, err := executeInEVM(ps, stateDB, hu, vmConfig, blkCtx.GasLimit, blkCtx.BlockHeight)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -240,12 +241,15 @@ func ExecuteContract(
 		receipt.AddTransactionLogs(stateDB.TransactionLogs()...)
 	}
 
-	if hu.IsPost(config.Hawaii, blkCtx.BlockHeight) && receipt.Status == uint64(iotextypes.ReceiptStatus_ErrExecutionReverted) && retval != nil && bytes.Equal(retval[:4], revertSelector) {
-		// in case of the execution revert error, parse the retVal and add to receipt
-		data := retval[4:]
-		msgLength := byteutil.BytesToUint64BigEndian(data[56:64])
-		revertMsg := string(data[64 : 64+msgLength])
-		receipt.SetExecutionRevertMsg(revertMsg)
+	if receipt.Status != uint64(iotextypes.ReceiptStatus_Success) {
+		if revertReason, ok := DecodeRevertReason(retval); ok {
+			if hu.IsPost(config.Hawaii, blkCtx.BlockHeight) && receipt.Status == uint64(iotextypes.ReceiptStatus_ErrExecutionReverted) {
+				// in case of the execution revert error, add the revert reason to receipt
+				receipt.SetExecutionRevertMsg(revertReason)
+			}
+			// the revert reason is decoded at all heights, which is not a part of consensus
+			receipt.SetRevertReason(revertReason)
+		}
 	}
 	log.S().Debugf("Receipt: %+v, %v", receipt, err)
 	return retval, receipt, nil
@@ -261,17 +265,16 @@ func getChainConfig(hu config.HeightUpgrade) *params.ChainConfig {
 	return &chainConfig
 }
 
-//Error in executeInEVM is a consensus issue
-func executeInEVM(evmParams *Params, stateDB *StateDBAdapter, hu config.HeightUpgrade, gasLimit uint64, blockHeight uint64) ([]byte, uint64, uint64, string, uint64, error) {
+// Error in executeInEVM is a consensus issue
+func executeInEVM(evmParams *Params, stateDB *StateDBAdapter, hu config.HeightUpgrade, vmConfig vm.Config, gasLimit uint64, blockHeight uint64) ([]byte, uint64, uint64, string, uint64, error) {
 	isBering := hu.IsPost(config.Bering, blockHeight)
 	remainingGas := evmParams.gas
 	if err := securityDeposit(evmParams, stateDB, gasLimit); err != nil {
 		log.L().Warn("unexpected error: not enough security deposit", zap.Error(err))
 		return nil, 0, 0, action.EmptyAddress, uint64(iotextypes.ReceiptStatus_Failure), err
 	}
-	var config vm.Config
 	chainConfig := getChainConfig(hu)
-	evm := vm.NewEVM(evmParams.context, stateDB, chainConfig, config)
+	evm := vm.NewEVM(evmParams.context, stateDB, chainConfig, vmConfig)
 	intriGas, err := intrinsicGas(evmParams.data)
 	if err != nil {
 		return nil, evmParams.gas, remainingGas, action.EmptyAddress, uint64(iotextypes.ReceiptStatus_Failure), err
@@ -367,6 +370,40 @@ func intrinsicGas(data []byte) (uint64, error) {
 	return dataSize*action.ExecutionDataGas + action.ExecutionBaseIntrinsicGas, nil
 }
 
+// DecodeRevertReason decodes the reason string from the return data of an execution reverted by Error(string)
+func DecodeRevertReason(ret []byte) (string, bool) {
+	if len(ret) < 4 || !bytes.Equal(ret[:4], revertSelector) {
+		return "", false
+	}
+	data := ret[4:]
+	offset, ok := abiWord(data, 0)
+	if !ok {
+		return "", false
+	}
+	length, ok := abiWord(data, offset)
+	if !ok {
+		return "", false
+	}
+	start := offset + 32
+	if start+length < start || start+length > uint64(len(data)) {
+		return "", false
+	}
+	return string(data[start : start+length]), true
+}
+
+// abiWord reads the 32-byte ABI word at pos as uint64
+func abiWord(data []byte, pos uint64) (uint64, bool) {
+	if pos+32 < pos || pos+32 > uint64(len(data)) {
+		return 0, false
+	}
+	for _, b := range data[pos : pos+24] {
+		if b != 0 {
+			return 0, false
+		}
+	}
+	return byteutil.BytesToUint64BigEndian(data[pos+24 : pos+32]), true
+}
+
 // SimulateExecution simulates the execution in evm
 func SimulateExecution(
 	ctx context.Context,
@@ -386,15 +423,22 @@ func SimulateExecution(
 	if err != nil {
 		return nil, nil, err
 	}
-	ctx = protocol.WithBlockCtx(
-		ctx,
-		protocol.BlockCtx{
-			BlockHeight:    bcCtx.Tip.Height + 1,
-			BlockTimeStamp: bcCtx.Tip.Timestamp.Add(bcCtx.Genesis.BlockInterval),
-			GasLimit:       bcCtx.Genesis.BlockGasLimit,
-			Producer:       zeroAddr,
-		},
-	)
+	blkCtx := protocol.BlockCtx{
+		BlockHeight:    bcCtx.Tip.Height + 1,
+		BlockTimeStamp: bcCtx.Tip.Timestamp.Add(bcCtx.Genesis.BlockInterval),
+		GasLimit:       bcCtx.Genesis.BlockGasLimit,
+		Producer:       zeroAddr,
+	}
+	override, overridden := GetStateOverrideCtx(ctx)
+	if overridden {
+		blkCtx = override.overrideBlockCtx(blkCtx)
+	}
+	ctx = protocol.WithBlockCtx(ctx, blkCtx)
+	if overridden {
+		if err := override.apply(ctx, sm); err != nil {
+			return nil, nil, err
+		}
+	}
 
 	return ExecuteContract(
 		ctx,
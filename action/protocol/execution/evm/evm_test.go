@@ -7,10 +7,12 @@
 package evm
 
 import (
+	"bytes"
 	"context"
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -188,3 +190,44 @@ func TestConstantinople(t *testing.T) {
 		require.Equal(hu.IsPre(config.Bering, e.height), evm.IsPreBering())
 	}
 }
+
+func TestDecodeRevertReason(t *testing.T) {
+	require := require.New(t)
+
+	reason, ok := DecodeRevertReason(revertPayload("oops"))
+	require.True(ok)
+	require.Equal("oops", reason)
+	reason, ok = DecodeRevertReason(revertPayload(""))
+	require.True(ok)
+	require.Equal("", reason)
+
+	for _, ret := range [][]byte{
+		nil,
+		{1, 2, 3},
+		// wrong selector
+		append([]byte{1, 2, 3, 4}, revertPayload("oops")[4:]...),
+		// truncated string
+		revertPayload("oops")[:70],
+		// length overflows
+		append(revertPayload("oops")[:36], bytes.Repeat([]byte{0xff}, 64)...),
+		// selector only
+		revertSelector,
+		// offset without length
+		revertPayload("oops")[:36],
+		// offset beyond payload
+		append(append(append([]byte{}, revertSelector...), common.LeftPadBytes([]byte{0xff, 0xff}, 32)...), revertPayload("oops")[36:]...),
+		// length larger than payload
+		append(append(append([]byte{}, revertPayload("oops")[:36]...), common.LeftPadBytes(big.NewInt(1<<62).Bytes(), 32)...), revertPayload("oops")[68:]...),
+	} {
+		_, ok = DecodeRevertReason(ret)
+		require.False(ok)
+	}
+}
+
+// revertPayload returns the return data of revert with Error(string)
+func revertPayload(reason string) []byte {
+	ret := append([]byte{}, revertSelector...)
+	ret = append(ret, common.LeftPadBytes([]byte{32}, 32)...)
+	ret = append(ret, common.LeftPadBytes(big.NewInt(int64(len(reason))).Bytes(), 32)...)
+	return append(ret, common.RightPadBytes([]byte(reason), (len(reason)+31)/32*32)...)
+}
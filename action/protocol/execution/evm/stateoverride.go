@@ -0,0 +1,137 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package evm
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/config"
+)
+
+type (
+	// AccountOverride overrides the state of an account in a simulated execution, a nil field is not overridden
+	AccountOverride struct {
+		Balance *big.Int
+		Nonce   *uint64
+		Code    []byte
+		// Storage overrides the given slots, the other slots are kept
+		Storage map[common.Hash]common.Hash
+	}
+
+	// StateOverride overrides the accounts and the block of a simulated execution
+	StateOverride struct {
+		// Accounts is keyed by the encoded address
+		Accounts map[string]*AccountOverride
+		// BlockHeight is not overridden if it is 0
+		BlockHeight uint64
+		// BlockTimeStamp is not overridden if it is zero
+		BlockTimeStamp time.Time
+	}
+
+	stateOverrideContextKey struct{}
+)
+
+// WithStateOverrideCtx adds the state override into context, which is applied by SimulateExecution
+func WithStateOverrideCtx(ctx context.Context, override *StateOverride) context.Context {
+	return context.WithValue(ctx, stateOverrideContextKey{}, override)
+}
+
+// GetStateOverrideCtx gets the state override from context
+func GetStateOverrideCtx(ctx context.Context) (*StateOverride, bool) {
+	override, ok := ctx.Value(stateOverrideContextKey{}).(*StateOverride)
+	return override, ok && override != nil
+}
+
+// overrideBlockCtx overrides the height and timestamp of the block context
+func (override *StateOverride) overrideBlockCtx(blkCtx protocol.BlockCtx) protocol.BlockCtx {
+	if override.BlockHeight != 0 {
+		blkCtx.BlockHeight = override.BlockHeight
+	}
+	if !override.BlockTimeStamp.IsZero() {
+		blkCtx.BlockTimeStamp = override.BlockTimeStamp
+	}
+	return blkCtx
+}
+
+// apply writes the overridden accounts into the state manager
+func (override *StateOverride) apply(ctx context.Context, sm protocol.StateManager) error {
+	addrs := make([]string, 0, len(override.Accounts))
+	for addr := range override.Accounts {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+
+	// code and storage are written through the contracts, which store the account with new code hash and storage root
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	bcCtx := protocol.MustGetBlockchainCtx(ctx)
+	hu := config.NewHeightUpgrade(&bcCtx.Genesis)
+	stateDB := NewStateDBAdapter(
+		sm,
+		blkCtx.BlockHeight,
+		hu.IsPre(config.Aleutian, blkCtx.BlockHeight),
+		hu.IsPost(config.Greenland, blkCtx.BlockHeight),
+		hash.ZeroHash256,
+	)
+	for _, encodedAddr := range addrs {
+		addr, err := address.FromString(encodedAddr)
+		if err != nil {
+			return errors.Wrapf(err, "invalid address %s in state override", encodedAddr)
+		}
+		account := override.Accounts[encodedAddr]
+		if account == nil {
+			continue
+		}
+		evmAddr := common.BytesToAddress(addr.Bytes())
+		if account.Code != nil {
+			stateDB.SetCode(evmAddr, account.Code)
+		}
+		for k, v := range account.Storage {
+			stateDB.SetState(evmAddr, k, v)
+		}
+	}
+	if err := stateDB.Error(); err != nil {
+		return errors.Wrap(err, "failed to override code and storage")
+	}
+	if err := stateDB.CommitContracts(); err != nil {
+		return errors.Wrap(err, "failed to override code and storage")
+	}
+
+	for _, encodedAddr := range addrs {
+		account := override.Accounts[encodedAddr]
+		if account == nil || (account.Balance == nil && account.Nonce == nil) {
+			continue
+		}
+		state, err := accountutil.LoadOrCreateAccount(sm, encodedAddr)
+		if err != nil {
+			return errors.Wrapf(err, "failed to load account %s", encodedAddr)
+		}
+		if account.Balance != nil {
+			if account.Balance.Sign() < 0 {
+				return errors.Errorf("invalid balance %s of account %s in state override", account.Balance, encodedAddr)
+			}
+			state.Balance = new(big.Int).Set(account.Balance)
+		}
+		if account.Nonce != nil {
+			state.Nonce = *account.Nonce
+		}
+		addr, _ := address.FromString(encodedAddr)
+		if err := accountutil.StoreAccount(sm, addr, state); err != nil {
+			return errors.Wrapf(err, "failed to override account %s", encodedAddr)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package evm
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+func TestStateOverride(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_, ok := GetStateOverrideCtx(context.Background())
+	require.False(ok)
+	_, ok = GetStateOverrideCtx(WithStateOverrideCtx(context.Background(), nil))
+	require.False(ok)
+
+	sm, err := initMockStateManager(ctrl)
+	require.NoError(err)
+	ctx := protocol.WithBlockchainCtx(context.Background(), protocol.BlockchainCtx{
+		Genesis: config.Default.Genesis,
+	})
+	ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{BlockHeight: 1})
+
+	eoa := identityset.Address(27)
+	contract := identityset.Address(28)
+	nonce := uint64(10)
+	key, value := common.BytesToHash([]byte{1}), common.BytesToHash([]byte{2})
+	override := &StateOverride{
+		Accounts: map[string]*AccountOverride{
+			eoa.String(): {
+				Balance: big.NewInt(100),
+				Nonce:   &nonce,
+			},
+			contract.String(): {
+				Balance: big.NewInt(200),
+				Code:    []byte{1, 2, 3},
+				Storage: map[common.Hash]common.Hash{key: value},
+			},
+		},
+		BlockHeight:    100,
+		BlockTimeStamp: time.Unix(1000, 0),
+	}
+	require.NoError(override.apply(ctx, sm))
+
+	stateDB := NewStateDBAdapter(sm, 1, true, false, hash.ZeroHash256)
+	eoaAddr, contractAddr := common.BytesToAddress(eoa.Bytes()), common.BytesToAddress(contract.Bytes())
+	require.Equal(big.NewInt(100), stateDB.GetBalance(eoaAddr))
+	require.Equal(nonce, stateDB.GetNonce(eoaAddr))
+	require.Empty(stateDB.GetCode(eoaAddr))
+	require.Equal(big.NewInt(200), stateDB.GetBalance(contractAddr))
+	require.Equal([]byte{1, 2, 3}, stateDB.GetCode(contractAddr))
+	require.Equal(value, stateDB.GetState(contractAddr, key))
+	require.Equal(common.Hash{}, stateDB.GetState(contractAddr, common.BytesToHash([]byte{3})))
+
+	blkCtx := override.overrideBlockCtx(protocol.BlockCtx{BlockHeight: 1, GasLimit: 10})
+	require.Equal(uint64(100), blkCtx.BlockHeight)
+	require.Equal(time.Unix(1000, 0), blkCtx.BlockTimeStamp)
+	require.Equal(uint64(10), blkCtx.GasLimit)
+
+	// invalid override
+	override = &StateOverride{Accounts: map[string]*AccountOverride{"io1abc": {Balance: big.NewInt(1)}}}
+	require.Error(override.apply(ctx, sm))
+	override = &StateOverride{Accounts: map[string]*AccountOverride{eoa.String(): {Balance: big.NewInt(-1)}}}
+	require.Error(override.apply(ctx, sm))
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package evm
+
+import (
+	"context"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/db/trie"
+	"github.com/iotexproject/iotex-core/db/trie/mptrie"
+)
+
+// GetStorageProof returns the merkle proof of a storage slot of the contract, which is the path in the storage trie
+// whose root hash is the storage root of the contract account
+func GetStorageProof(sr protocol.StateReader, addr hash.Hash160, key hash.Hash256) ([][]byte, error) {
+	account, err := accountutil.LoadAccount(sr, addr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load account %x", addr)
+	}
+	options := []mptrie.Option{
+		mptrie.KVStoreOption(newKVStoreForTrieWithStateReader(ContractKVNameSpace, sr)),
+		mptrie.KeyLengthOption(len(hash.Hash256{})),
+		mptrie.HashFuncOption(storageTrieHashFunc(addr)),
+	}
+	if account.Root != hash.ZeroHash256 {
+		options = append(options, mptrie.RootHashOption(account.Root[:]))
+	}
+	tr, err := mptrie.New(options...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create storage trie")
+	}
+	if err := tr.Start(context.Background()); err != nil {
+		return nil, err
+	}
+	defer tr.Stop(context.Background())
+
+	return tr.GetProof(key[:])
+}
+
+// VerifyStorageProof verifies the proof of a storage slot against the storage root of the contract, and returns the
+// value of the slot. trie.ErrNotExist is returned if the proof shows the slot does not exist
+func VerifyStorageProof(storageRoot hash.Hash256, addr hash.Hash160, key hash.Hash256, proof [][]byte) ([]byte, error) {
+	if storageRoot == hash.ZeroHash256 {
+		// the contract has never written its storage
+		return nil, trie.ErrNotExist
+	}
+	return mptrie.VerifyProof(storageRoot[:], key[:], proof, storageTrieHashFunc(addr))
+}
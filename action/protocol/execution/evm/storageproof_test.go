@@ -0,0 +1,66 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package evm
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/db/trie"
+	"github.com/iotexproject/iotex-core/db/trie/mptrie"
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+func TestStorageProof(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sm, err := initMockStateManager(ctrl)
+	require.NoError(err)
+	addr := identityset.Address(28)
+	addrHash := hash.BytesToHash160(addr.Bytes())
+	evmAddr := common.BytesToAddress(addr.Bytes())
+	k1, k2, k3 := hash.BytesToHash256([]byte{1}), hash.BytesToHash256([]byte{2}), hash.BytesToHash256([]byte{3})
+
+	// no storage
+	proof, err := GetStorageProof(sm, addrHash, k1)
+	require.NoError(err)
+	_, err = VerifyStorageProof(hash.ZeroHash256, addrHash, k1, proof)
+	require.Equal(trie.ErrNotExist, errors.Cause(err))
+
+	stateDB := NewStateDBAdapter(sm, 1, false, true, hash.ZeroHash256)
+	stateDB.SetCode(evmAddr, []byte{1})
+	stateDB.SetState(evmAddr, common.BytesToHash(k1[:]), common.BytesToHash([]byte{10}))
+	stateDB.SetState(evmAddr, common.BytesToHash(k2[:]), common.BytesToHash([]byte{20}))
+	require.NoError(stateDB.CommitContracts())
+	account, err := accountutil.LoadAccount(sm, addrHash)
+	require.NoError(err)
+	require.NotEqual(hash.ZeroHash256, account.Root)
+
+	proof, err = GetStorageProof(sm, addrHash, k1)
+	require.NoError(err)
+	value, err := VerifyStorageProof(account.Root, addrHash, k1, proof)
+	require.NoError(err)
+	require.Equal(common.BytesToHash([]byte{10}).Bytes(), value)
+	// the storage trie of another contract has a different hash func
+	_, err = VerifyStorageProof(account.Root, hash.BytesToHash160(identityset.Address(29).Bytes()), k1, proof)
+	require.Equal(mptrie.ErrInvalidProof, errors.Cause(err))
+	_, err = VerifyStorageProof(account.Root, addrHash, k2, proof)
+	require.Error(err)
+
+	proof, err = GetStorageProof(sm, addrHash, k3)
+	require.NoError(err)
+	_, err = VerifyStorageProof(account.Root, addrHash, k3, proof)
+	require.Equal(trie.ErrNotExist, errors.Cause(err))
+}
@@ -98,4 +98,10 @@ type (
 		Unload(string, string, interface{}) error
 		Reset()
 	}
+
+	// CloneableDock is a dock which can be copied, so that its data can be reverted along with the states
+	CloneableDock interface {
+		Dock
+		Clone() CloneableDock
+	}
 )
@@ -40,6 +40,9 @@ type (
 		logs               []*Log
 		transactionLogs    []*TransactionLog
 		executionRevertMsg string
+		// revertReason is decoded at all heights when the execution is run, which is not a part of consensus and not
+		// kept in serialization, so receipts read from the chain db only have executionRevertMsg
+		revertReason string
 	}
 
 	// Log stores an evm contract event
@@ -163,6 +166,17 @@ func (receipt *Receipt) SetExecutionRevertMsg(revertReason string) *Receipt {
 	return receipt
 }
 
+// RevertReason returns the reason decoded from the return data of execution reverted by Error(string)
+func (receipt *Receipt) RevertReason() string {
+	return receipt.revertReason
+}
+
+// SetRevertReason sets the revert reason to receipt
+func (receipt *Receipt) SetRevertReason(revertReason string) *Receipt {
+	receipt.revertReason = revertReason
+	return receipt
+}
+
 // ConvertToLogPb converts a Log to protobuf's Log
 func (log *Log) ConvertToLogPb() *iotextypes.Log {
 	l := &iotextypes.Log{}
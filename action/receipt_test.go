@@ -37,7 +37,7 @@ func TestConvert(t *testing.T) {
 	testLog := newTestLog()
 	testLog.Topics = topics
 	testLog.NotFixTopicCopyBug = true
-	receipt := &Receipt{1, 1, hash.ZeroHash256, 1, "test", []*Log{testLog}, nil, "balance not enough"}
+	receipt := &Receipt{1, 1, hash.ZeroHash256, 1, "test", []*Log{testLog}, nil, "balance not enough", ""}
 
 	typeReceipt := receipt.ConvertToReceiptPb()
 	require.NotNil(typeReceipt)
@@ -64,7 +64,7 @@ func TestConvert(t *testing.T) {
 
 func TestSerDer(t *testing.T) {
 	require := require.New(t)
-	receipt := &Receipt{1, 1, hash.ZeroHash256, 1, "", nil, nil, ""}
+	receipt := &Receipt{1, 1, hash.ZeroHash256, 1, "", nil, nil, "", ""}
 	ser, err := receipt.Serialize()
 	require.NoError(err)
 
@@ -85,6 +85,26 @@ func TestSerDer(t *testing.T) {
 	hash2 := receipt.Hash()
 	require.NotEqual(oldHash, hex.EncodeToString(hash2[:]))
 }
+
+func TestReceiptRevertReason(t *testing.T) {
+	require := require.New(t)
+	receipt := &Receipt{Status: 106, BlockHeight: 1, ActionHash: hash.ZeroHash256, GasConsumed: 1}
+	h := receipt.Hash()
+
+	// revert reason is not a part of consensus, and not kept in serialization
+	receipt.SetRevertReason("balance not enough")
+	require.Equal("balance not enough", receipt.RevertReason())
+	require.Equal(h, receipt.Hash())
+	require.Empty(receipt.ConvertToReceiptPb().ProtoReflect().GetUnknown())
+	ser, err := receipt.Serialize()
+	require.NoError(err)
+	receipt2 := &Receipt{}
+	require.NoError(receipt2.Deserialize(ser))
+	require.Empty(receipt2.RevertReason())
+	require.Empty(receipt2.ExecutionRevertMsg())
+	require.Equal(h, receipt2.Hash())
+}
+
 func TestConvertLog(t *testing.T) {
 	require := require.New(t)
 
@@ -0,0 +1,209 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+)
+
+// Encoding is the encoding of the content signed by the sender of a sealed envelope
+type Encoding uint64
+
+const (
+	// IotexProtobuf means the sender signs the hash of protobuf-serialized envelope
+	IotexProtobuf Encoding = iota
+	// EthereumRLP means the sender signs an RLP-encoded Ethereum transaction per EIP-155
+	EthereumRLP
+)
+
+// the signature of IoTeX action is in [R || S || V] format where V is 0 or 1 (or 27 or 28), while the signature of
+// Ethereum transaction keeps the V of EIP-155 signer in big-endian, which is chainID*2+35 or chainID*2+36, so the
+// encoding and the EVM network ID are recovered from the signature as Ethereum does
+const (
+	_sigRSLength  = 64
+	_eip155VBase  = 35
+	_maxEthVBytes = 5
+)
+
+// ErrInvalidRawTx indicates the error of RLP-encoded transaction
+var ErrInvalidRawTx = errors.New("invalid raw transaction")
+
+// DecodeRawTx decodes an RLP-encoded Ethereum transaction signed per EIP-155 with the given EVM network ID, and
+// maps it to a sealed transfer, or a sealed execution if it creates a contract or carries data
+func DecodeRawTx(raw []byte, evmNetworkID uint32) (SealedEnvelope, error) {
+	tx := &types.Transaction{}
+	if err := rlp.DecodeBytes(raw, tx); err != nil {
+		return SealedEnvelope{}, errors.Wrap(ErrInvalidRawTx, err.Error())
+	}
+	if !tx.Protected() {
+		return SealedEnvelope{}, errors.Wrap(ErrInvalidRawTx, "transaction is not replay-protected per EIP-155")
+	}
+	if tx.ChainId().Cmp(new(big.Int).SetUint64(uint64(evmNetworkID))) != 0 {
+		return SealedEnvelope{}, errors.Wrapf(ErrInvalidRawTx, "chain ID %d does not match %d", tx.ChainId(), evmNetworkID)
+	}
+	sig, err := rawTxSignature(tx, evmNetworkID)
+	if err != nil {
+		return SealedEnvelope{}, err
+	}
+	h := types.NewEIP155Signer(tx.ChainId()).Hash(tx)
+	pubKey, err := crypto.RecoverPubkey(h[:], sig)
+	if err != nil {
+		return SealedEnvelope{}, errors.Wrapf(ErrInvalidRawTx, "failed to recover sender: %v", err)
+	}
+
+	var payload actionPayload
+	if tx.To() != nil && len(tx.Data()) == 0 {
+		recipient, err := address.FromBytes(tx.To().Bytes())
+		if err != nil {
+			return SealedEnvelope{}, err
+		}
+		payload, err = NewTransfer(tx.Nonce(), tx.Value(), recipient.String(), nil, tx.Gas(), tx.GasPrice())
+		if err != nil {
+			return SealedEnvelope{}, err
+		}
+	} else {
+		contract := EmptyAddress
+		if tx.To() != nil {
+			addr, err := address.FromBytes(tx.To().Bytes())
+			if err != nil {
+				return SealedEnvelope{}, err
+			}
+			contract = addr.String()
+		}
+		payload, err = NewExecution(contract, tx.Nonce(), tx.Value(), tx.Gas(), tx.GasPrice(), tx.Data())
+		if err != nil {
+			return SealedEnvelope{}, err
+		}
+	}
+	elp := (&EnvelopeBuilder{}).
+		SetNonce(tx.Nonce()).
+		SetGasLimit(tx.Gas()).
+		SetGasPrice(tx.GasPrice()).
+		SetAction(payload).
+		Build()
+	v, _, _ := tx.RawSignatureValues()
+	sealed := SealedEnvelope{
+		Envelope:     elp,
+		srcPubkey:    pubKey,
+		signature:    append(sig[:_sigRSLength:_sigRSLength], v.Bytes()...),
+		encoding:     EthereumRLP,
+		evmNetworkID: evmNetworkID,
+	}
+	// the action hash must be the hash of the original transaction
+	txHash, err := sealed.ethTxHash()
+	if err != nil {
+		return SealedEnvelope{}, errors.Wrap(ErrInvalidRawTx, err.Error())
+	}
+	if txHash != hash.BytesToHash256(tx.Hash().Bytes()) {
+		return SealedEnvelope{}, errors.Wrap(ErrInvalidRawTx, "transaction cannot be represented as an action")
+	}
+	sealed.payload.SetEnvelopeContext(sealed)
+	return sealed, nil
+}
+
+// rawTxSignature returns the signature in [R || S || V] format where V is 0 or 1
+func rawTxSignature(tx *types.Transaction, evmNetworkID uint32) ([]byte, error) {
+	v, r, s := tx.RawSignatureValues()
+	recID := new(big.Int).Sub(v, big.NewInt(int64(evmNetworkID)*2+35))
+	if !recID.IsUint64() || recID.Uint64() > 1 {
+		return nil, errors.Wrapf(ErrInvalidRawTx, "invalid signature V %d", v)
+	}
+	rb, sb := r.Bytes(), s.Bytes()
+	if len(rb) > 32 || len(sb) > 32 {
+		return nil, errors.Wrap(ErrInvalidRawTx, "invalid signature R or S")
+	}
+	sig := make([]byte, 65)
+	copy(sig[32-len(rb):32], rb)
+	copy(sig[64-len(sb):64], sb)
+	sig[64] = byte(recID.Uint64())
+	return sig, nil
+}
+
+// toEthTx converts the envelope into an unsigned Ethereum transaction
+func (elp *Envelope) toEthTx() (*types.Transaction, error) {
+	switch act := elp.Action().(type) {
+	case *Transfer:
+		to, err := address.FromString(act.Recipient())
+		if err != nil {
+			return nil, err
+		}
+		return types.NewTransaction(elp.Nonce(), common.BytesToAddress(to.Bytes()), act.Amount(), elp.GasLimit(), elp.GasPrice(), act.Payload()), nil
+	case *Execution:
+		if act.Contract() == EmptyAddress {
+			return types.NewContractCreation(elp.Nonce(), act.Amount(), elp.GasLimit(), elp.GasPrice(), act.Data()), nil
+		}
+		to, err := address.FromString(act.Contract())
+		if err != nil {
+			return nil, err
+		}
+		return types.NewTransaction(elp.Nonce(), common.BytesToAddress(to.Bytes()), act.Amount(), elp.GasLimit(), elp.GasPrice(), act.Data()), nil
+	default:
+		return nil, errors.Errorf("action %T cannot be encoded as Ethereum transaction", act)
+	}
+}
+
+// ethSigningHash returns the hash signed by the sender per EIP-155
+func (sealed *SealedEnvelope) ethSigningHash() (hash.Hash256, error) {
+	tx, err := sealed.toEthTx()
+	if err != nil {
+		return hash.ZeroHash256, err
+	}
+	h := types.NewEIP155Signer(new(big.Int).SetUint64(uint64(sealed.evmNetworkID))).Hash(tx)
+	return hash.BytesToHash256(h.Bytes()), nil
+}
+
+// ethTxHash returns the hash of the signed Ethereum transaction
+func (sealed *SealedEnvelope) ethTxHash() (hash.Hash256, error) {
+	tx, err := sealed.toEthTx()
+	if err != nil {
+		return hash.ZeroHash256, err
+	}
+	signedTx, err := tx.WithSignature(types.NewEIP155Signer(new(big.Int).SetUint64(uint64(sealed.evmNetworkID))), sealed.ethSignature())
+	if err != nil {
+		return hash.ZeroHash256, err
+	}
+	return hash.BytesToHash256(signedTx.Hash().Bytes()), nil
+}
+
+// ethSignature returns the signature of Ethereum transaction in [R || S || V] format where V is 0 or 1
+func (sealed *SealedEnvelope) ethSignature() []byte {
+	sig := make([]byte, _sigRSLength+1)
+	copy(sig, sealed.signature[:_sigRSLength])
+	v := new(big.Int).SetBytes(sealed.signature[_sigRSLength:]).Uint64()
+	sig[_sigRSLength] = byte(v - _eip155VBase - uint64(sealed.evmNetworkID)*2)
+	return sig
+}
+
+// loadEncoding recovers the encoding and the EVM network ID from the V of signature
+func (sealed *SealedEnvelope) loadEncoding() error {
+	sig := sealed.signature
+	if len(sig) < _sigRSLength+1 || len(sig) > _sigRSLength+_maxEthVBytes {
+		// the invalid signature is rejected in verification
+		return nil
+	}
+	if len(sig) == _sigRSLength+1 && sig[_sigRSLength] < _eip155VBase {
+		return nil
+	}
+	v := new(big.Int).SetBytes(sig[_sigRSLength:]).Uint64()
+	if v < _eip155VBase || (v-_eip155VBase)/2 > math.MaxUint32 {
+		return errors.Errorf("invalid signature V %d", v)
+	}
+	sealed.encoding = EthereumRLP
+	sealed.evmNetworkID = uint32((v - _eip155VBase) / 2)
+	// the action must be convertible to Ethereum transaction to verify the signature and to be hashed
+	_, err := sealed.ethTxHash()
+	return err
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+func TestDecodeRawTx(t *testing.T) {
+	require := require.New(t)
+
+	const evmNetworkID = 4689
+	sk := identityset.PrivateKey(27).EcdsaPrivateKey().(*ecdsa.PrivateKey)
+	recipient := identityset.Address(28)
+	to := common.BytesToAddress(recipient.Bytes())
+	signRawTx := func(tx *types.Transaction, signer types.Signer) (*types.Transaction, []byte) {
+		signedTx, err := types.SignTx(tx, signer, sk)
+		require.NoError(err)
+		raw, err := rlp.EncodeToBytes(signedTx)
+		require.NoError(err)
+		return signedTx, raw
+	}
+	signer := types.NewEIP155Signer(big.NewInt(evmNetworkID))
+
+	for _, test := range []struct {
+		tx       *types.Transaction
+		contract string
+	}{
+		{types.NewTransaction(1, to, big.NewInt(100), 21000, big.NewInt(1000), nil), ""},
+		{types.NewTransaction(2, to, big.NewInt(0), 100000, big.NewInt(1000), []byte{1, 2, 3}), recipient.String()},
+		{types.NewContractCreation(3, big.NewInt(0), 100000, big.NewInt(1000), []byte{4, 5, 6}), EmptyAddress},
+	} {
+		signedTx, raw := signRawTx(test.tx, signer)
+		selp, err := DecodeRawTx(raw, evmNetworkID)
+		require.NoError(err)
+		require.Equal(EthereumRLP, selp.Encoding())
+		require.Equal(uint32(evmNetworkID), selp.EVMNetworkID())
+		require.Equal(hash.BytesToHash256(signedTx.Hash().Bytes()), selp.Hash())
+		require.Equal(identityset.Address(27).String(), mustSender(t, selp))
+		require.Equal(test.tx.Nonce(), selp.Nonce())
+		require.Equal(test.tx.Gas(), selp.GasLimit())
+		require.Equal(test.tx.GasPrice(), selp.GasPrice())
+		switch act := selp.Action().(type) {
+		case *Transfer:
+			require.Equal(recipient.String(), act.Recipient())
+			require.Equal(test.tx.Value(), act.Amount())
+		case *Execution:
+			require.Equal(test.contract, act.Contract())
+			require.Equal(test.tx.Data(), act.Data())
+		}
+		require.NoError(Verify(selp))
+
+		// the encoding and the EVM network ID are recovered from the V of signature in serialization
+		v, _, _ := signedTx.RawSignatureValues()
+		require.Equal(v.Bytes(), selp.Signature()[64:])
+		b, err := proto.Marshal(selp.Proto())
+		require.NoError(err)
+		pbAct := &iotextypes.Action{}
+		require.NoError(proto.Unmarshal(b, pbAct))
+		require.Empty(pbAct.ProtoReflect().GetUnknown())
+		loaded := SealedEnvelope{}
+		require.NoError(loaded.LoadProto(pbAct))
+		require.Equal(EthereumRLP, loaded.Encoding())
+		require.Equal(uint32(evmNetworkID), loaded.EVMNetworkID())
+		require.Equal(selp.Hash(), loaded.Hash())
+		require.NoError(Verify(loaded))
+
+		// signature over the protobuf hash is rejected
+		fake := AssembleSealedEnvelope(loaded.Envelope, loaded.SrcPubkey(), loaded.ethSignature())
+		require.Equal(IotexProtobuf, fake.Encoding())
+		require.Error(Verify(fake))
+	}
+
+	// wrong chain ID
+	_, raw := signRawTx(types.NewTransaction(1, to, big.NewInt(100), 21000, big.NewInt(1000), nil), types.NewEIP155Signer(big.NewInt(1)))
+	_, err := DecodeRawTx(raw, evmNetworkID)
+	require.Equal(ErrInvalidRawTx, errors.Cause(err))
+	// not replay-protected
+	_, raw = signRawTx(types.NewTransaction(1, to, big.NewInt(100), 21000, big.NewInt(1000), nil), types.HomesteadSigner{})
+	_, err = DecodeRawTx(raw, evmNetworkID)
+	require.Equal(ErrInvalidRawTx, errors.Cause(err))
+	// not RLP
+	_, err = DecodeRawTx([]byte{1, 2, 3}, evmNetworkID)
+	require.Equal(ErrInvalidRawTx, errors.Cause(err))
+
+	// the signature of IoTeX action is loaded as protobuf encoding
+	tsf, err := NewTransfer(1, big.NewInt(100), recipient.String(), nil, 21000, big.NewInt(1000))
+	require.NoError(err)
+	elp := (&EnvelopeBuilder{}).SetNonce(1).SetGasLimit(21000).SetGasPrice(big.NewInt(1000)).SetAction(tsf).Build()
+	selp, err := Sign(elp, identityset.PrivateKey(27))
+	require.NoError(err)
+	loaded := SealedEnvelope{}
+	require.NoError(loaded.LoadProto(selp.Proto()))
+	require.Equal(IotexProtobuf, loaded.Encoding())
+	require.Equal(selp.Hash(), loaded.Hash())
+	require.NoError(Verify(loaded))
+	// the V of signature cannot carry a network ID beyond uint32
+	pbAct := selp.Proto()
+	pbAct.Signature = append(pbAct.Signature[:64], 0x03, 0, 0, 0, 0)
+	require.Error(loaded.LoadProto(pbAct))
+	// the action that cannot be hashed as Ethereum transaction is rejected
+	v := big.NewInt(evmNetworkID*2 + _eip155VBase).Bytes()
+	deposit := (&DepositToRewardingFundBuilder{}).SetAmount(big.NewInt(1)).Build()
+	elp = (&EnvelopeBuilder{}).SetNonce(1).SetGasLimit(21000).SetGasPrice(big.NewInt(1000)).SetAction(&deposit).Build()
+	selp, err = Sign(elp, identityset.PrivateKey(27))
+	require.NoError(err)
+	pbAct = selp.Proto()
+	pbAct.Signature = append(pbAct.Signature[:64], v...)
+	require.Error(loaded.LoadProto(pbAct))
+	tsf, err = NewTransfer(1, big.NewInt(100), "invalid", nil, 21000, big.NewInt(1000))
+	require.NoError(err)
+	elp = (&EnvelopeBuilder{}).SetNonce(1).SetGasLimit(21000).SetGasPrice(big.NewInt(1000)).SetAction(tsf).Build()
+	selp, err = Sign(elp, identityset.PrivateKey(27))
+	require.NoError(err)
+	pbAct = selp.Proto()
+	pbAct.Signature = append(pbAct.Signature[:64], v...)
+	require.Error(loaded.LoadProto(pbAct))
+	// hashing such an envelope violates the invariant of decoding
+	selp.encoding = EthereumRLP
+	require.Panics(func() { selp.Hash() })
+}
+
+func mustSender(t *testing.T, selp SealedEnvelope) string {
+	addr, err := address.FromBytes(selp.SrcPubkey().Hash())
+	require.NoError(t, err)
+	return addr.String()
+}
@@ -6,7 +6,9 @@ import (
 	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 	"github.com/pkg/errors"
+	"go.uber.org/zap"
 
+	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
 )
 
@@ -14,15 +16,32 @@ import (
 type SealedEnvelope struct {
 	Envelope
 
-	srcPubkey crypto.PublicKey
-	signature []byte
+	srcPubkey    crypto.PublicKey
+	signature    []byte
+	encoding     Encoding
+	evmNetworkID uint32
 }
 
 // Hash returns the hash value of SealedEnvelope.
+// For an Ethereum transaction, it is the hash of the original RLP-encoded transaction.
 func (sealed *SealedEnvelope) Hash() hash.Hash256 {
+	if sealed.encoding == EthereumRLP {
+		h, err := sealed.ethTxHash()
+		if err != nil {
+			// the envelope has been checked to be convertible when it is decoded
+			log.L().Panic("Failed to hash Ethereum transaction.", zap.Error(err))
+		}
+		return h
+	}
 	return hash.Hash256b(byteutil.Must(proto.Marshal(sealed.Proto())))
 }
 
+// Encoding returns the encoding of the content signed by the sender
+func (sealed *SealedEnvelope) Encoding() Encoding { return sealed.encoding }
+
+// EVMNetworkID returns the EVM network ID of EIP-155 signer, only set for Ethereum transaction
+func (sealed *SealedEnvelope) EVMNetworkID() uint32 { return sealed.evmNetworkID }
+
 // SrcPubkey returns the source public key
 func (sealed *SealedEnvelope) SrcPubkey() crypto.PublicKey { return sealed.srcPubkey }
 
@@ -62,6 +81,9 @@ func (sealed *SealedEnvelope) LoadProto(pbAct *iotextypes.Action) error {
 	if err := sealed.Envelope.LoadProto(pbAct.GetCore()); err != nil {
 		return err
 	}
+	if err := sealed.loadEncoding(); err != nil {
+		return err
+	}
 
 	sealed.payload.SetEnvelopeContext(*sealed)
 	return nil
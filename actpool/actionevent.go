@@ -0,0 +1,153 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package actpool
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/go-pkgs/hash"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+// ActionEventType is the type of the change of a pending action in pool
+type ActionEventType int
+
+const (
+	// ActionAdded means the action is accepted into pool
+	ActionAdded ActionEventType = iota
+	// ActionReplaced means the action is accepted into pool to replace a pending action with the same nonce
+	ActionReplaced
+	// ActionDropped means the action is removed from pool without being committed
+	ActionDropped
+	// ActionConfirmed means the action is removed from pool because it has been committed to block
+	ActionConfirmed
+)
+
+// reasons of dropping an action from pool
+const (
+	DropReasonEvicted     = "evictedByGasPrice"
+	DropReasonInvalidated = "invalidated"
+	DropReasonBlackListed = "blacklisted"
+	DropReasonDeleted     = "deleted"
+	// ReplaceReasonGasPrice is the reason of replacing a pending action
+	ReplaceReasonGasPrice = "replacedByGasPrice"
+)
+
+// eventBufferSize is the number of pending events buffered for each subscriber
+const eventBufferSize = 1024
+
+type (
+	// ActionEvent describes a change of pending action in pool
+	ActionEvent struct {
+		Type   ActionEventType
+		Action action.SealedEnvelope
+		Hash   hash.Hash256
+		Sender string
+		// Reason tells why the action is replaced or dropped
+		Reason string
+		// Replaced is the hash of the pending action replaced by this one, only set for ActionReplaced
+		Replaced hash.Hash256
+	}
+
+	// ActionEventSubscriber receives the events of pending actions in pool
+	ActionEventSubscriber interface {
+		ReceiveActionEvent(*ActionEvent) error
+	}
+
+	eventSub struct {
+		subscriber ActionEventSubscriber
+		events     chan *ActionEvent
+		cancel     chan interface{}
+	}
+
+	// eventFeed delivers action events to subscribers, so that a slow subscriber will not block the pool
+	eventFeed struct {
+		mutex sync.RWMutex
+		subs  []*eventSub
+	}
+)
+
+func newEventFeed() *eventFeed {
+	return &eventFeed{
+		subs: make([]*eventSub, 0),
+	}
+}
+
+// Subscribe adds a subscriber, which starts receiving events in a separate goroutine
+func (ef *eventFeed) Subscribe(s ActionEventSubscriber) error {
+	ef.mutex.Lock()
+	defer ef.mutex.Unlock()
+
+	for _, sub := range ef.subs {
+		if sub.subscriber == s {
+			return errors.New("subscriber already added")
+		}
+	}
+	sub := &eventSub{
+		subscriber: s,
+		events:     make(chan *ActionEvent, eventBufferSize),
+		cancel:     make(chan interface{}),
+	}
+	go sub.handle()
+	ef.subs = append(ef.subs, sub)
+	return nil
+}
+
+// Unsubscribe removes a subscriber
+func (ef *eventFeed) Unsubscribe(s ActionEventSubscriber) error {
+	ef.mutex.Lock()
+	defer ef.mutex.Unlock()
+
+	for i, sub := range ef.subs {
+		if sub.subscriber == s {
+			close(sub.cancel)
+			ef.subs = append(ef.subs[:i], ef.subs[i+1:]...)
+			return nil
+		}
+	}
+	return errors.New("cannot find subscription")
+}
+
+// HasSubscriber returns true if there is any subscriber
+func (ef *eventFeed) HasSubscriber() bool {
+	ef.mutex.RLock()
+	defer ef.mutex.RUnlock()
+
+	return len(ef.subs) > 0
+}
+
+// Send sends the event to every subscriber, the event is discarded for a subscriber whose buffer is full
+func (ef *eventFeed) Send(evt *ActionEvent) {
+	ef.mutex.RLock()
+	defer ef.mutex.RUnlock()
+
+	for _, sub := range ef.subs {
+		select {
+		case sub.events <- evt:
+		default:
+			actpoolMtc.WithLabelValues("discardedActionEvent").Inc()
+		}
+	}
+}
+
+func (sub *eventSub) handle() {
+	for {
+		select {
+		case <-sub.cancel:
+			return
+		case evt := <-sub.events:
+			if err := sub.subscriber.ReceiveActionEvent(evt); err != nil {
+				log.L().Error("Failed to handle action event.", zap.Error(err))
+			}
+		}
+	}
+}
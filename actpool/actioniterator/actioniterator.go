@@ -14,29 +14,37 @@ import (
 	"github.com/iotexproject/iotex-core/action"
 )
 
-// ActionByPrice implements both the sort and the heap interface, making it useful
+// actionHeap implements both the sort and the heap interface, making it useful
 // for all at once sorting as well as individually adding and removing elements.
-// It's essentially a big root heap of actions
-type actionByPrice []action.SealedEnvelope
+// It's essentially a heap of actions ordered by the less function, e.g., a big root heap of actions by gas price
+type actionHeap struct {
+	acts []action.SealedEnvelope
+	less func(a, b action.SealedEnvelope) bool
+}
 
-func (s actionByPrice) Len() int           { return len(s) }
-func (s actionByPrice) Less(i, j int) bool { return s[i].GasPrice().Cmp(s[j].GasPrice()) > 0 }
-func (s actionByPrice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s *actionHeap) Len() int           { return len(s.acts) }
+func (s *actionHeap) Less(i, j int) bool { return s.less(s.acts[i], s.acts[j]) }
+func (s *actionHeap) Swap(i, j int)      { s.acts[i], s.acts[j] = s.acts[j], s.acts[i] }
 
 // Push define the push function of heap
-func (s *actionByPrice) Push(x interface{}) {
-	*s = append(*s, x.(action.SealedEnvelope))
+func (s *actionHeap) Push(x interface{}) {
+	s.acts = append(s.acts, x.(action.SealedEnvelope))
 }
 
 // Pop define the pop function of heap
-func (s *actionByPrice) Pop() interface{} {
-	old := *s
+func (s *actionHeap) Pop() interface{} {
+	old := s.acts
 	n := len(old)
 	x := old[n-1]
-	*s = old[0 : n-1]
+	s.acts = old[0 : n-1]
 	return x
 }
 
+// byPrice orders actions by gas price descending
+func byPrice(a, b action.SealedEnvelope) bool {
+	return a.GasPrice().Cmp(b.GasPrice()) > 0
+}
+
 // ActionIterator define the interface of action iterator
 type ActionIterator interface {
 	Next() (action.SealedEnvelope, bool)
@@ -45,25 +53,37 @@ type ActionIterator interface {
 
 type actionIterator struct {
 	accountActs map[string][]action.SealedEnvelope
-	heads       actionByPrice
+	heads       *actionHeap
 }
 
-// NewActionIterator return a new action iterator
+// NewActionIterator return a new action iterator, which picks the head action with the highest gas price among accounts
 func NewActionIterator(accountActs map[string][]action.SealedEnvelope) ActionIterator {
-	heads := make(actionByPrice, 0, len(accountActs))
+	return NewActionIteratorWithOrder(accountActs, byPrice)
+}
+
+// NewActionIteratorWithOrder return a new action iterator, which picks the first head action among accounts
+// according to the given order
+func NewActionIteratorWithOrder(
+	accountActs map[string][]action.SealedEnvelope,
+	less func(a, b action.SealedEnvelope) bool,
+) ActionIterator {
+	heads := &actionHeap{
+		acts: make([]action.SealedEnvelope, 0, len(accountActs)),
+		less: less,
+	}
 	for sender, accActs := range accountActs {
 		if len(accActs) == 0 {
 			continue
 		}
 
-		heads = append(heads, accActs[0])
+		heads.acts = append(heads.acts, accActs[0])
 		if len(accActs) > 1 {
 			accountActs[sender] = accActs[1:]
 		} else {
 			accountActs[sender] = []action.SealedEnvelope{}
 		}
 	}
-	heap.Init(&heads)
+	heap.Init(heads)
 	return &actionIterator{
 		accountActs: accountActs,
 		heads:       heads,
@@ -72,31 +92,31 @@ func NewActionIterator(accountActs map[string][]action.SealedEnvelope) ActionIte
 
 // LoadNext load next action of account of top action
 func (ai *actionIterator) loadNextActionForTopAccount() {
-	sender := ai.heads[0].SrcPubkey()
+	sender := ai.heads.acts[0].SrcPubkey()
 	callerAddr, _ := address.FromBytes(sender.Hash())
 	callerAddrStr := callerAddr.String()
 	if actions, ok := ai.accountActs[callerAddrStr]; ok && len(actions) > 0 {
-		ai.heads[0], ai.accountActs[callerAddrStr] = actions[0], actions[1:]
-		heap.Fix(&ai.heads, 0)
+		ai.heads.acts[0], ai.accountActs[callerAddrStr] = actions[0], actions[1:]
+		heap.Fix(ai.heads, 0)
 	} else {
-		heap.Pop(&ai.heads)
+		heap.Pop(ai.heads)
 	}
 }
 
 // Next load next action of account of top action
 func (ai *actionIterator) Next() (action.SealedEnvelope, bool) {
-	if len(ai.heads) == 0 {
+	if ai.heads.Len() == 0 {
 		return action.SealedEnvelope{}, false
 	}
 
-	headAction := ai.heads[0]
+	headAction := ai.heads.acts[0]
 	ai.loadNextActionForTopAccount()
 	return headAction, true
 }
 
 // PopAccount will remove all actions related to this account
 func (ai *actionIterator) PopAccount() {
-	if len(ai.heads) != 0 {
-		heap.Pop(&ai.heads)
+	if ai.heads.Len() != 0 {
+		heap.Pop(ai.heads)
 	}
 }
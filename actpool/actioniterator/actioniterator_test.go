@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-address/address"
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/test/identityset"
@@ -101,6 +102,49 @@ func TestActionIterator(t *testing.T) {
 	require.Equal(appliedActionList, []action.SealedEnvelope{selp3, selp1, selp2, selp4, selp5, selp6})
 }
 
+func TestActionIteratorWithOrder(t *testing.T) {
+	require := require.New(t)
+
+	a := identityset.Address(28)
+	priKeyA := identityset.PrivateKey(28)
+	b := identityset.Address(29)
+	priKeyB := identityset.PrivateKey(29)
+	accMap := make(map[string][]action.SealedEnvelope)
+	order := make(map[hash.Hash256]int)
+	newAction := func(nonce uint64, gasPrice int64, priKey crypto.PrivateKey, arrival int) action.SealedEnvelope {
+		tsf, err := action.NewTransfer(nonce, big.NewInt(100), "1", nil, uint64(0), big.NewInt(gasPrice))
+		require.NoError(err)
+		bd := &action.EnvelopeBuilder{}
+		elp := bd.SetNonce(nonce).
+			SetGasPrice(big.NewInt(gasPrice)).
+			SetAction(tsf).Build()
+		selp, err := action.Sign(elp, priKey)
+		require.NoError(err)
+		order[selp.Hash()] = arrival
+		return selp
+	}
+	selp1 := newAction(1, 1, priKeyA, 2)
+	selp2 := newAction(2, 100, priKeyA, 3)
+	selp3 := newAction(1, 50, priKeyB, 0)
+	selp4 := newAction(2, 50, priKeyB, 4)
+	accMap[a.String()] = []action.SealedEnvelope{selp1, selp2}
+	accMap[b.String()] = []action.SealedEnvelope{selp3, selp4}
+
+	// first come first served
+	ai := NewActionIteratorWithOrder(accMap, func(x, y action.SealedEnvelope) bool {
+		return order[x.Hash()] < order[y.Hash()]
+	})
+	appliedActionList := make([]action.SealedEnvelope, 0)
+	for {
+		nextAction, ok := ai.Next()
+		if !ok {
+			break
+		}
+		appliedActionList = append(appliedActionList, nextAction)
+	}
+	require.Equal([]action.SealedEnvelope{selp3, selp1, selp2, selp4}, appliedActionList)
+}
+
 func BenchmarkLooping(b *testing.B) {
 	accMap := make(map[string][]action.SealedEnvelope)
 	for i := 0; i < b.N; i++ {
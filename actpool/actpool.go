@@ -8,6 +8,7 @@ package actpool
 
 import (
 	"context"
+	"math/big"
 	"sort"
 	"strings"
 	"sync"
@@ -24,8 +25,12 @@ import (
 	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/pkg/prometheustimer"
+	"github.com/iotexproject/iotex-core/pkg/ratelimit"
+	"github.com/iotexproject/iotex-core/state"
 )
 
 var (
@@ -42,6 +47,7 @@ func init() {
 // ActPool is the interface of actpool
 type ActPool interface {
 	action.SealedEnvelopeValidator
+	lifecycle.StartStopper
 	// Reset resets actpool state
 	Reset()
 	// PendingActionMap returns an action map with all accepted actions
@@ -52,8 +58,12 @@ type ActPool interface {
 	GetPendingNonce(addr string) (uint64, error)
 	// GetUnconfirmedActs returns unconfirmed actions in pool given an account address
 	GetUnconfirmedActs(addr string) []action.SealedEnvelope
+	// GetPendingAccount returns the view of an account with its actions in pool
+	GetPendingAccount(addr string) (*PendingAccount, error)
 	// GetActionByHash returns the pending action in pool given action's hash
 	GetActionByHash(hash hash.Hash256) (action.SealedEnvelope, error)
+	// ArrivalOrder returns the order in which the pending action is added into pool
+	ArrivalOrder(hash hash.Hash256) (uint64, bool)
 	// GetSize returns the act pool size
 	GetSize() uint64
 	// GetCapacity returns the act pool capacity
@@ -66,10 +76,36 @@ type ActPool interface {
 	DeleteAction(address.Address)
 	// ReceiveBlock will be called when a new block is committed
 	ReceiveBlock(*block.Block) error
+	// AddToBlackList bans an account address from initiating actions, and removes its pending actions from pool
+	AddToBlackList(addr string)
+	// RemoveFromBlackList lifts the ban on an account address
+	RemoveFromBlackList(addr string)
+	// BlackList returns the banned account addresses
+	BlackList() []string
+	// AddActionEventSubscriber adds a subscriber of the events of pending actions
+	AddActionEventSubscriber(ActionEventSubscriber) error
+	// RemoveActionEventSubscriber removes a subscriber of the events of pending actions
+	RemoveActionEventSubscriber(ActionEventSubscriber) error
 
 	AddActionEnvelopeValidators(...action.SealedEnvelopeValidator)
 }
 
+// PendingAccount is the view of an account with its actions in pool
+type PendingAccount struct {
+	ConfirmedNonce   uint64
+	ConfirmedBalance *big.Int
+	// PendingNonce is the nonce of the next action after the executable actions
+	PendingNonce uint64
+	// PendingBalance is the balance after paying for the executable actions
+	PendingBalance *big.Int
+	// Executable are the actions with consecutive nonces after the confirmed nonce, which the balance can pay for
+	Executable []action.SealedEnvelope
+	// Queued are the actions waiting for the missing nonces, in the order of nonce
+	Queued []action.SealedEnvelope
+	// NonceGaps are the missing nonces from the pending nonce to the highest nonce of queued actions
+	NonceGaps []uint64
+}
+
 // SortedActions is a slice of actions that implements sort.Interface to sort by Value.
 type SortedActions []action.SealedEnvelope
 
@@ -88,6 +124,17 @@ func EnableExperimentalActions() Option {
 	}
 }
 
+// EnableJournal enables the action pool to persist accepted actions into the given KVStore
+func EnableJournal(kv db.KVStore) Option {
+	return func(pool *actPool) error {
+		if kv == nil {
+			return errors.New("journal KVStore cannot be nil")
+		}
+		pool.journal = newActJournal(kv)
+		return nil
+	}
+}
+
 // actPool implements ActPool interface
 type actPool struct {
 	mutex                     sync.RWMutex
@@ -101,6 +148,11 @@ type actPool struct {
 	timerFactory              *prometheustimer.TimerFactory
 	enableExperimentalActions bool
 	senderBlackList           map[string]bool
+	journal                   *actJournal
+	priceIndex                *priceIndex
+	accountLimiter            *ratelimit.Limiter
+	peerLimiter               *ratelimit.Limiter
+	events                    *eventFeed
 }
 
 // NewActPool constructs a new actpool
@@ -121,6 +173,12 @@ func NewActPool(sf protocol.StateReader, cfg config.ActPool, opts ...Option) (Ac
 		accountActs:     make(map[string]ActQueue),
 		accountDesActs:  make(map[string]map[hash.Hash256]action.SealedEnvelope),
 		allActions:      make(map[hash.Hash256]action.SealedEnvelope),
+		priceIndex:      newPriceIndex(),
+		events:          newEventFeed(),
+	}
+	if cfg.EnableRateLimit {
+		ap.accountLimiter = ratelimit.New(cfg.RateLimit.AccountAvg, cfg.RateLimit.AccountBurst)
+		ap.peerLimiter = ratelimit.New(cfg.RateLimit.PeerAvg, cfg.RateLimit.PeerBurst)
 	}
 	for _, opt := range opts {
 		if err := opt(ap); err != nil {
@@ -140,6 +198,53 @@ func NewActPool(sf protocol.StateReader, cfg config.ActPool, opts ...Option) (Ac
 	return ap, nil
 }
 
+// Start starts the actpool, and recovers the journaled actions if journal is enabled
+func (ap *actPool) Start(ctx context.Context) error {
+	if ap.journal == nil {
+		return nil
+	}
+	if err := ap.journal.Start(ctx); err != nil {
+		return errors.Wrap(err, "failed to start actpool journal")
+	}
+	acts, err := ap.journal.Actions()
+	if err != nil {
+		return errors.Wrap(err, "failed to load actpool journal")
+	}
+	// Replay in nonce order, so that actions of the same account are accepted consecutively
+	sort.Stable(SortedActions(acts))
+
+	ap.mutex.Lock()
+	defer ap.mutex.Unlock()
+	for _, act := range acts {
+		actHash := act.Hash()
+		caller, err := address.FromBytes(act.SrcPubkey().Hash())
+		if err != nil {
+			log.L().Debug("Discarded journaled action.", log.Hex("hash", actHash[:]), zap.Error(err))
+			continue
+		}
+		confirmedState, err := accountutil.AccountState(ap.sf, caller.String())
+		if err != nil {
+			return errors.Wrapf(err, "failed to get sender's nonce for journaled action %x", actHash)
+		}
+		if act.Nonce() <= confirmedState.Nonce {
+			continue
+		}
+		if err := ap.add(ctx, act); err != nil {
+			log.L().Debug("Discarded journaled action.", log.Hex("hash", actHash[:]), zap.Error(err))
+		}
+	}
+	log.L().Info("Recovered actions from actpool journal.", zap.Int("journaled", len(acts)), zap.Int("recovered", len(ap.allActions)))
+	return ap.journal.Compact(ap.allActions)
+}
+
+// Stop stops the actpool
+func (ap *actPool) Stop(ctx context.Context) error {
+	if ap.journal == nil {
+		return nil
+	}
+	return ap.journal.Stop(ctx)
+}
+
 func (ap *actPool) AddActionEnvelopeValidators(fs ...action.SealedEnvelopeValidator) {
 	ap.actionEnvelopeValidators = append(ap.actionEnvelopeValidators, fs...)
 }
@@ -159,11 +264,25 @@ func (ap *actPool) Reset() {
 	ap.reset()
 }
 
+// ReceiveBlock resets the pool by the state after the block. The actpool is not a BlockRevertSubscriber, the actions
+// of a block reverted from the tip are not re-injected into the pool, because the state is not reverted along with the
+// block, and the actions would be rejected by their nonces. They come back once the state is rebuilt, and the actions
+// are broadcast again by their senders
 func (ap *actPool) ReceiveBlock(*block.Block) error {
 	ap.mutex.Lock()
 	defer ap.mutex.Unlock()
 
 	ap.reset()
+	if ap.accountLimiter != nil {
+		ap.accountLimiter.Prune()
+		ap.peerLimiter.Prune()
+	}
+	if ap.journal != nil {
+		// Remove confirmed and invalidated actions from journal
+		if err := ap.journal.Compact(ap.allActions); err != nil {
+			log.L().Error("Error when compacting actpool journal.", zap.Error(err))
+		}
+	}
 	return nil
 }
 
@@ -186,20 +305,21 @@ func (ap *actPool) Add(ctx context.Context, act action.SealedEnvelope) error {
 	ap.mutex.Lock()
 	defer ap.mutex.Unlock()
 
-	// Reject action if pool space is full
-	if uint64(len(ap.allActions)) >= ap.cfg.MaxNumActsPerPool {
-		actpoolMtc.WithLabelValues("overMaxNumActsPerPool").Inc()
-		return errors.Wrap(action.ErrActPool, "insufficient space for action")
+	if err := ap.checkRateLimit(ctx, act); err != nil {
+		return err
 	}
-	intrinsicGas, err := act.IntrinsicGas()
-	if err != nil {
-		actpoolMtc.WithLabelValues("failedGetIntrinsicGas").Inc()
-		return errors.Wrap(err, "failed to get action's intrinsic gas")
+	if err := ap.add(ctx, act); err != nil {
+		return err
 	}
-	if ap.gasInPool+intrinsicGas > ap.cfg.MaxGasLimitPerPool {
-		actpoolMtc.WithLabelValues("overMaxGasLimitPerPool").Inc()
-		return errors.Wrap(action.ErrActPool, "insufficient gas space for action")
+	if ap.journal != nil {
+		if err := ap.journal.Put(act); err != nil {
+			log.L().Error("Error when journaling action.", zap.Error(err))
+		}
 	}
+	return nil
+}
+
+func (ap *actPool) add(ctx context.Context, act action.SealedEnvelope) error {
 	hash := act.Hash()
 	// Reject action if it already exists in pool
 	if _, exist := ap.allActions[hash]; exist {
@@ -242,6 +362,46 @@ func (ap *actPool) GetPendingNonce(addr string) (uint64, error) {
 	return confirmedState.Nonce + 1, err
 }
 
+// GetPendingAccount returns the view of an account with its actions in pool
+func (ap *actPool) GetPendingAccount(addr string) (*PendingAccount, error) {
+	ap.mutex.RLock()
+	defer ap.mutex.RUnlock()
+
+	confirmedState, err := accountutil.AccountState(ap.sf, addr)
+	if err != nil {
+		return nil, err
+	}
+	pa := &PendingAccount{
+		ConfirmedNonce:   confirmedState.Nonce,
+		ConfirmedBalance: new(big.Int).Set(confirmedState.Balance),
+		PendingNonce:     confirmedState.Nonce + 1,
+		PendingBalance:   new(big.Int).Set(confirmedState.Balance),
+		Executable:       []action.SealedEnvelope{},
+		Queued:           []action.SealedEnvelope{},
+		NonceGaps:        []uint64{},
+	}
+	queue, ok := ap.accountActs[addr]
+	if !ok {
+		return pa, nil
+	}
+	pa.PendingNonce = queue.PendingNonce()
+	pa.PendingBalance = new(big.Int).Set(queue.PendingBalance())
+	next := pa.PendingNonce
+	for _, act := range queue.AllActs() {
+		nonce := act.Nonce()
+		if nonce < pa.PendingNonce {
+			pa.Executable = append(pa.Executable, act)
+			continue
+		}
+		for ; next < nonce; next++ {
+			pa.NonceGaps = append(pa.NonceGaps, next)
+		}
+		pa.Queued = append(pa.Queued, act)
+		next = nonce + 1
+	}
+	return pa, nil
+}
+
 // GetUnconfirmedActs returns unconfirmed actions in pool given an account address
 func (ap *actPool) GetUnconfirmedActs(addr string) []action.SealedEnvelope {
 	ap.mutex.RLock()
@@ -275,6 +435,14 @@ func (ap *actPool) GetActionByHash(hash hash.Hash256) (action.SealedEnvelope, er
 	return act, nil
 }
 
+// ArrivalOrder returns the order in which the pending action is added into pool
+func (ap *actPool) ArrivalOrder(hash hash.Hash256) (uint64, bool) {
+	ap.mutex.RLock()
+	defer ap.mutex.RUnlock()
+
+	return ap.priceIndex.Arrival(hash)
+}
+
 // GetSize returns the act pool size
 func (ap *actPool) GetSize() uint64 {
 	ap.mutex.RLock()
@@ -308,11 +476,61 @@ func (ap *actPool) Validate(ctx context.Context, selp action.SealedEnvelope) err
 }
 
 func (ap *actPool) DeleteAction(caller address.Address) {
-	ap.mutex.RLock()
-	defer ap.mutex.RUnlock()
+	ap.mutex.Lock()
+	defer ap.mutex.Unlock()
 	pendingActs := ap.accountActs[caller.String()].AllActs()
 	ap.removeInvalidActs(pendingActs)
+	ap.notify(ActionDropped, DropReasonDeleted, pendingActs...)
 	delete(ap.accountActs, caller.String())
+	ap.priceIndex.DeleteTail(caller.String())
+}
+
+// AddToBlackList bans an account address from initiating actions, and removes its pending actions from pool
+func (ap *actPool) AddToBlackList(addr string) {
+	ap.mutex.Lock()
+	defer ap.mutex.Unlock()
+
+	ap.senderBlackList[addr] = true
+	if queue, ok := ap.accountActs[addr]; ok {
+		acts := queue.AllActs()
+		ap.removeInvalidActs(acts)
+		ap.notify(ActionDropped, DropReasonBlackListed, acts...)
+		delete(ap.accountActs, addr)
+		ap.priceIndex.DeleteTail(addr)
+	}
+	log.L().Info("Added address to actpool blacklist.", zap.String("address", addr))
+}
+
+// RemoveFromBlackList lifts the ban on an account address
+func (ap *actPool) RemoveFromBlackList(addr string) {
+	ap.mutex.Lock()
+	defer ap.mutex.Unlock()
+
+	delete(ap.senderBlackList, addr)
+	log.L().Info("Removed address from actpool blacklist.", zap.String("address", addr))
+}
+
+// BlackList returns the banned account addresses
+func (ap *actPool) BlackList() []string {
+	ap.mutex.RLock()
+	defer ap.mutex.RUnlock()
+
+	addrs := make([]string, 0, len(ap.senderBlackList))
+	for addr := range ap.senderBlackList {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+	return addrs
+}
+
+// AddActionEventSubscriber adds a subscriber of the events of pending actions
+func (ap *actPool) AddActionEventSubscriber(s ActionEventSubscriber) error {
+	return ap.events.Subscribe(s)
+}
+
+// RemoveActionEventSubscriber removes a subscriber of the events of pending actions
+func (ap *actPool) RemoveActionEventSubscriber(s ActionEventSubscriber) error {
+	return ap.events.Unsubscribe(s)
 }
 
 func (ap *actPool) validate(ctx context.Context, selp action.SealedEnvelope) error {
@@ -340,6 +558,25 @@ func (ap *actPool) validate(ctx context.Context, selp action.SealedEnvelope) err
 //======================================
 // private functions
 //======================================
+func (ap *actPool) checkRateLimit(ctx context.Context, act action.SealedEnvelope) error {
+	if ap.accountLimiter == nil {
+		return nil
+	}
+	if peer, ok := GetPeer(ctx); ok && !ap.peerLimiter.Allow(peer) {
+		actpoolMtc.WithLabelValues("overPeerRateLimit").Inc()
+		return errors.Wrapf(action.ErrActPool, "too many actions from peer %s", peer)
+	}
+	caller, err := address.FromBytes(act.SrcPubkey().Hash())
+	if err != nil {
+		return err
+	}
+	if !ap.accountLimiter.Allow(caller.String()) {
+		actpoolMtc.WithLabelValues("overAccountRateLimit").Inc()
+		return errors.Wrapf(action.ErrActPool, "too many actions from account %s", caller.String())
+	}
+	return nil
+}
+
 func (ap *actPool) enqueueAction(sender string, act action.SealedEnvelope, actHash hash.Hash256, actNonce uint64) error {
 	confirmedState, err := accountutil.AccountState(ap.sf, sender)
 	if err != nil {
@@ -349,25 +586,9 @@ func (ap *actPool) enqueueAction(sender string, act action.SealedEnvelope, actHa
 	confirmedNonce := confirmedState.Nonce
 
 	queue := ap.accountActs[sender]
-	if queue == nil {
-		queue = NewActQueue(ap, sender, WithTimeOut(ap.cfg.ActionExpiry))
-		ap.accountActs[sender] = queue
-
-		// Initialize pending nonce for new account
-		pendingNonce := confirmedNonce + 1
-		queue.SetPendingNonce(pendingNonce)
-		// Initialize balance for new account
-		state, err := accountutil.AccountState(ap.sf, sender)
-		if err != nil {
-			actpoolMtc.WithLabelValues("failedToGetBalance").Inc()
-			return errors.Wrapf(err, "failed to get sender's balance for action %x", actHash)
-		}
-		queue.SetPendingBalance(state.Balance)
-	}
-	if queue.Overlaps(act) {
-		// Nonce already exists
-		actpoolMtc.WithLabelValues("nonceUsed").Inc()
-		return errors.Wrapf(action.ErrNonce, "duplicate nonce for action %x", actHash)
+	if queue != nil && queue.Overlaps(act) {
+		// Nonce already exists, try to replace the pending action with the same nonce
+		return ap.replaceAction(sender, queue, confirmedState, act, actHash)
 	}
 
 	if actNonce-confirmedNonce-1 >= ap.cfg.MaxNumActsPerAcct {
@@ -385,7 +606,12 @@ func (ap *actPool) enqueueAction(sender string, act action.SealedEnvelope, actHa
 		actpoolMtc.WithLabelValues("failedToGetCost").Inc()
 		return errors.Wrapf(err, "failed to get cost of action %x", actHash)
 	}
-	if queue.PendingBalance().Cmp(cost) < 0 {
+	// The pending balance of new account is its confirmed balance
+	pendingBalance := confirmedState.Balance
+	if queue != nil {
+		pendingBalance = queue.PendingBalance()
+	}
+	if pendingBalance.Cmp(cost) < 0 {
 		// Pending balance is insufficient
 		actpoolMtc.WithLabelValues("insufficientBalance").Inc()
 		return errors.Wrapf(
@@ -393,29 +619,48 @@ func (ap *actPool) enqueueAction(sender string, act action.SealedEnvelope, actHa
 			"insufficient balance for action %x, cost = %s, pending balance = %s, sender = %s",
 			actHash,
 			cost.String(),
-			queue.PendingBalance().String(),
+			pendingBalance.String(),
 			sender,
 		)
 	}
+	intrinsicGas, err := act.IntrinsicGas()
+	if err != nil {
+		actpoolMtc.WithLabelValues("failedGetIntrinsicGas").Inc()
+		return errors.Wrap(err, "failed to get action's intrinsic gas")
+	}
+	// Evict actions with lower gas price if pool space is full, only after the action passes all the checks above
+	if err := ap.makeRoom(sender, act, 1, intrinsicGas); err != nil {
+		return err
+	}
+
+	if queue == nil {
+		queue = NewActQueue(ap, sender, WithTimeOut(ap.cfg.ActionExpiry))
+		ap.accountActs[sender] = queue
 
+		// Initialize pending nonce for new account
+		pendingNonce := confirmedNonce + 1
+		queue.SetPendingNonce(pendingNonce)
+		// Initialize balance for new account
+		state, err := accountutil.AccountState(ap.sf, sender)
+		if err != nil {
+			actpoolMtc.WithLabelValues("failedToGetBalance").Inc()
+			return errors.Wrapf(err, "failed to get sender's balance for action %x", actHash)
+		}
+		queue.SetPendingBalance(state.Balance)
+	}
 	if err := queue.Put(act); err != nil {
 		actpoolMtc.WithLabelValues("failedPutActQueue").Inc()
 		return errors.Wrapf(err, "cannot put action %x into ActQueue", actHash)
 	}
 	ap.allActions[actHash] = act
+	ap.priceIndex.Add(actHash)
+	ap.updateTail(sender)
 
 	//add actions to destination map
-	desAddress, ok := act.Destination()
-	if ok && !strings.EqualFold(sender, desAddress) {
-		desQueue := ap.accountDesActs[desAddress]
-		if desQueue == nil {
-			ap.accountDesActs[desAddress] = make(map[hash.Hash256]action.SealedEnvelope)
-		}
-		ap.accountDesActs[desAddress][actHash] = act
-	}
+	ap.addDestinationAction(sender, act, actHash)
 
-	intrinsicGas, _ := act.IntrinsicGas()
 	ap.gasInPool += intrinsicGas
+	ap.notify(ActionAdded, "", act)
 	// If the pending nonce equals this nonce, update queue
 	nonce := queue.PendingNonce()
 	if actNonce == nonce {
@@ -424,6 +669,161 @@ func (ap *actPool) enqueueAction(sender string, act action.SealedEnvelope, actHa
 	return nil
 }
 
+// replaceAction replaces the action in queue with the same nonce, if the new one offers a high enough gas price
+func (ap *actPool) replaceAction(
+	sender string,
+	queue ActQueue,
+	confirmedState *state.Account,
+	act action.SealedEnvelope,
+	actHash hash.Hash256,
+) error {
+	var old action.SealedEnvelope
+	for _, pending := range queue.AllActs() {
+		if pending.Nonce() == act.Nonce() {
+			old = pending
+			break
+		}
+	}
+	if !ap.enoughGasPriceBump(old.GasPrice(), act.GasPrice()) {
+		actpoolMtc.WithLabelValues("nonceUsed").Inc()
+		return errors.Wrapf(
+			action.ErrNonce,
+			"duplicate nonce for action %x, gas price %s is not enough to replace existing action with gas price %s",
+			actHash,
+			act.GasPrice().String(),
+			old.GasPrice().String(),
+		)
+	}
+	cost, err := act.Cost()
+	if err != nil {
+		actpoolMtc.WithLabelValues("failedToGetCost").Inc()
+		return errors.Wrapf(err, "failed to get cost of action %x", actHash)
+	}
+	// The cost of the replaced action has been deducted from pending balance if it is pending
+	balance := new(big.Int).Set(queue.PendingBalance())
+	if old.Nonce() < queue.PendingNonce() {
+		oldCost, err := old.Cost()
+		if err != nil {
+			actpoolMtc.WithLabelValues("failedToGetCost").Inc()
+			return errors.Wrapf(err, "failed to get cost of action %x", old.Hash())
+		}
+		balance.Add(balance, oldCost)
+	}
+	if balance.Cmp(cost) < 0 {
+		actpoolMtc.WithLabelValues("insufficientBalance").Inc()
+		return errors.Wrapf(
+			action.ErrBalance,
+			"insufficient balance for action %x, cost = %s, pending balance = %s, sender = %s",
+			actHash,
+			cost.String(),
+			balance.String(),
+			sender,
+		)
+	}
+	intrinsicGas, err := act.IntrinsicGas()
+	if err != nil {
+		actpoolMtc.WithLabelValues("failedGetIntrinsicGas").Inc()
+		return errors.Wrap(err, "failed to get action's intrinsic gas")
+	}
+	oldGas, _ := old.IntrinsicGas()
+	// The replacement takes the place of the replaced action, but evicts others if it takes more gas space of pool
+	if intrinsicGas > oldGas {
+		if err := ap.makeRoom(sender, act, 0, intrinsicGas-oldGas); err != nil {
+			return err
+		}
+	}
+	if _, err := queue.Replace(act); err != nil {
+		actpoolMtc.WithLabelValues("failedPutActQueue").Inc()
+		return errors.Wrapf(err, "cannot replace action %x in ActQueue", actHash)
+	}
+	oldHash := old.Hash()
+	log.L().Debug("Replaced action.",
+		log.Hex("old", oldHash[:]),
+		log.Hex("new", actHash[:]),
+		zap.Uint64("nonce", act.Nonce()))
+	delete(ap.allActions, oldHash)
+	ap.priceIndex.Remove(oldHash)
+	ap.subGasFromPool(oldGas)
+	ap.deleteAccountDestinationActions(old)
+
+	ap.allActions[actHash] = act
+	ap.priceIndex.Add(actHash)
+	ap.addDestinationAction(sender, act, actHash)
+	ap.gasInPool += intrinsicGas
+	if ap.events.HasSubscriber() {
+		ap.events.Send(&ActionEvent{
+			Type:     ActionReplaced,
+			Action:   act,
+			Hash:     actHash,
+			Sender:   sender,
+			Reason:   ReplaceReasonGasPrice,
+			Replaced: oldHash,
+		})
+	}
+
+	// Re-evaluate pending nonce and balance of the account, since the cost of the replaced action may differ
+	queue.SetPendingBalance(confirmedState.Balance)
+	queue.SetPendingNonce(confirmedState.Nonce + 1)
+	ap.updateAccount(sender)
+	return nil
+}
+
+// enoughGasPriceBump checks whether the new gas price exceeds the old one by at least GasPriceBumpPct percent
+func (ap *actPool) enoughGasPriceBump(oldPrice, newPrice *big.Int) bool {
+	if newPrice.Cmp(oldPrice) <= 0 {
+		return false
+	}
+	threshold := new(big.Int).Mul(oldPrice, new(big.Int).SetUint64(100+ap.cfg.GasPriceBumpPct))
+	return new(big.Int).Mul(newPrice, big.NewInt(100)).Cmp(threshold) >= 0
+}
+
+// makeRoom evicts the lowest-priced tail actions of other accounts, until the pool has enough space for the numActs
+// actions taking intrinsicGas more gas space
+func (ap *actPool) makeRoom(sender string, act action.SealedEnvelope, numActs, intrinsicGas uint64) error {
+	for {
+		var reason string
+		switch {
+		case uint64(len(ap.allActions))+numActs > ap.cfg.MaxNumActsPerPool:
+			reason = "overMaxNumActsPerPool"
+		case ap.gasInPool+intrinsicGas > ap.cfg.MaxGasLimitPerPool:
+			reason = "overMaxGasLimitPerPool"
+		default:
+			return nil
+		}
+		// Only the tail action can be evicted without leaving a nonce gap
+		victim := ap.priceIndex.LowestPriced(act.GasPrice(), sender)
+		if victim == nil {
+			actpoolMtc.WithLabelValues(reason).Inc()
+			if reason == "overMaxNumActsPerPool" {
+				return errors.Wrap(action.ErrActPool, "insufficient space for action")
+			}
+			return errors.Wrap(action.ErrActPool, "insufficient gas space for action")
+		}
+		ap.evictAction(victim)
+		actpoolMtc.WithLabelValues("evictedByGasPrice").Inc()
+	}
+}
+
+// evictAction removes the tail action of an account from pool
+func (ap *actPool) evictAction(victim *pricedAction) {
+	queue := ap.accountActs[victim.sender]
+	evicted, _ := queue.PopTail()
+	log.L().Debug("Evicted action with low gas price.",
+		log.Hex("hash", victim.hash[:]),
+		zap.String("gasPrice", victim.gasPrice.String()))
+	ap.removeInvalidActs([]action.SealedEnvelope{evicted})
+	ap.notify(ActionDropped, DropReasonEvicted, evicted)
+	if queue.Empty() {
+		delete(ap.accountActs, victim.sender)
+	} else if nonce := evicted.Nonce(); nonce < queue.PendingNonce() {
+		// Return the cost of evicted action to pending balance, if it has been deducted
+		cost, _ := evicted.Cost()
+		queue.SetPendingBalance(new(big.Int).Add(queue.PendingBalance(), cost))
+		queue.SetPendingNonce(nonce)
+	}
+	ap.updateTail(victim.sender)
+}
+
 // removeConfirmedActs removes processed (committed to block) actions from pool
 func (ap *actPool) removeConfirmedActs() {
 	for from, queue := range ap.accountActs {
@@ -436,12 +836,14 @@ func (ap *actPool) removeConfirmedActs() {
 		// Remove all actions that are committed to new block
 		acts := queue.FilterNonce(pendingNonce)
 		ap.removeInvalidActs(acts)
+		ap.notify(ActionConfirmed, "", acts...)
 		//del actions in destination map
 		ap.deleteAccountDestinationActions(acts...)
 		// Delete the queue entry if it becomes empty
 		if queue.Empty() {
 			delete(ap.accountActs, from)
 		}
+		ap.updateTail(from)
 	}
 }
 
@@ -450,6 +852,7 @@ func (ap *actPool) removeInvalidActs(acts []action.SealedEnvelope) {
 		hash := act.Hash()
 		log.L().Debug("Removed invalidated action.", log.Hex("hash", hash[:]))
 		delete(ap.allActions, hash)
+		ap.priceIndex.Remove(hash)
 		intrinsicGas, _ := act.IntrinsicGas()
 		ap.subGasFromPool(intrinsicGas)
 		//del actions in destination map
@@ -457,6 +860,37 @@ func (ap *actPool) removeInvalidActs(acts []action.SealedEnvelope) {
 	}
 }
 
+// notify sends the events of the given actions to subscribers
+func (ap *actPool) notify(typ ActionEventType, reason string, acts ...action.SealedEnvelope) {
+	if !ap.events.HasSubscriber() {
+		return
+	}
+	for _, act := range acts {
+		evt := &ActionEvent{
+			Type:   typ,
+			Action: act,
+			Hash:   act.Hash(),
+			Reason: reason,
+		}
+		if caller, err := address.FromBytes(act.SrcPubkey().Hash()); err == nil {
+			evt.Sender = caller.String()
+		}
+		ap.events.Send(evt)
+	}
+}
+
+// addDestinationAction adds action to destination map
+func (ap *actPool) addDestinationAction(sender string, act action.SealedEnvelope, actHash hash.Hash256) {
+	desAddress, ok := act.Destination()
+	if ok && !strings.EqualFold(sender, desAddress) {
+		desQueue := ap.accountDesActs[desAddress]
+		if desQueue == nil {
+			ap.accountDesActs[desAddress] = make(map[hash.Hash256]action.SealedEnvelope)
+		}
+		ap.accountDesActs[desAddress][actHash] = act
+	}
+}
+
 // deleteAccountDestinationActions just for destination map
 func (ap *actPool) deleteAccountDestinationActions(acts ...action.SealedEnvelope) {
 	for _, act := range acts {
@@ -476,11 +910,24 @@ func (ap *actPool) updateAccount(sender string) {
 	acts := queue.UpdateQueue(queue.PendingNonce())
 	if len(acts) > 0 {
 		ap.removeInvalidActs(acts)
+		ap.notify(ActionDropped, DropReasonInvalidated, acts...)
 	}
 	// Delete the queue entry if it becomes empty
 	if queue.Empty() {
 		delete(ap.accountActs, sender)
 	}
+	ap.updateTail(sender)
+}
+
+// updateTail updates the tail action of the account in price index, which is the candidate to evict from pool
+func (ap *actPool) updateTail(sender string) {
+	if queue, ok := ap.accountActs[sender]; ok {
+		if tail, ok := queue.Tail(); ok {
+			ap.priceIndex.SetTail(sender, tail)
+			return
+		}
+	}
+	ap.priceIndex.DeleteTail(sender)
 }
 
 func (ap *actPool) reset() {
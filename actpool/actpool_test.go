@@ -11,6 +11,7 @@ import (
 	"context"
 	"math/big"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -19,6 +20,8 @@ import (
 	"github.com/iotexproject/iotex-core/test/mock/mock_sealed_envelope_validator"
 
 	"github.com/golang/mock/gomock"
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/iotex-address/address"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
@@ -29,6 +32,7 @@ import (
 	"github.com/iotexproject/iotex-core/action/protocol/rewarding"
 	"github.com/iotexproject/iotex-core/blockchain"
 	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
 	"github.com/iotexproject/iotex-core/test/identityset"
 	"github.com/iotexproject/iotex-core/testutil"
 )
@@ -232,7 +236,7 @@ func TestActPool_AddActs(t *testing.T) {
 		require.NoError(err)
 		ap3.gasInPool += intrinsicGas
 	}
-	tsf10, err := testutil.SignedTransfer(addr2, priKey2, uint64(apConfig.MaxGasLimitPerPool/10000), big.NewInt(50), []byte{1, 2, 3}, uint64(20000), big.NewInt(0))
+	tsf10, err := testutil.SignedTransfer(addr2, priKey2, uint64(1), big.NewInt(5), []byte{1, 2, 3}, uint64(20000), big.NewInt(0))
 	require.NoError(err)
 	err = ap3.Add(ctx, tsf10)
 	require.True(strings.Contains(err.Error(), "insufficient gas space for action"))
@@ -289,6 +293,458 @@ func TestActPool_AddActs(t *testing.T) {
 	require.Equal(action.ErrInsufficientBalanceForGas, errors.Cause(err))
 }
 
+func TestActPool_ReplaceAction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	require := require.New(t)
+	sf := mock_chainmanager.NewMockStateReader(ctrl)
+	sf.EXPECT().State(gomock.Any(), gomock.Any()).DoAndReturn(func(account interface{}, opts ...protocol.StateOption) (uint64, error) {
+		acct, ok := account.(*state.Account)
+		require.True(ok)
+		acct.Nonce = 0
+		acct.Balance = big.NewInt(10000000)
+		return 0, nil
+	}).AnyTimes()
+	apConfig := getActPoolCfg()
+	apConfig.GasPriceBumpPct = 10
+	Ap, err := NewActPool(sf, apConfig, EnableExperimentalActions())
+	require.NoError(err)
+	ap, ok := Ap.(*actPool)
+	require.True(ok)
+	ctx := context.Background()
+
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, uint64(1), big.NewInt(10), []byte{}, uint64(10000), big.NewInt(100))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr2, priKey1, uint64(2), big.NewInt(20), []byte{}, uint64(10000), big.NewInt(100))
+	require.NoError(err)
+	require.NoError(ap.Add(ctx, tsf1))
+	require.NoError(ap.Add(ctx, tsf2))
+	pBalance, _ := ap.getPendingBalance(addr1)
+	require.Equal(uint64(10000000-2000000-30), pBalance.Uint64())
+
+	// Case I: gas price bump is not enough
+	underpriced, err := testutil.SignedTransfer(addr3, priKey1, uint64(1), big.NewInt(10), []byte{}, uint64(10000), big.NewInt(109))
+	require.NoError(err)
+	require.Equal(action.ErrNonce, errors.Cause(ap.Add(ctx, underpriced)))
+	// Case II: insufficient balance for the replacement
+	overBalance, err := testutil.SignedTransfer(addr3, priKey1, uint64(1), big.NewInt(10), []byte{}, uint64(10000), big.NewInt(10000))
+	require.NoError(err)
+	require.Equal(action.ErrBalance, errors.Cause(ap.Add(ctx, overBalance)))
+	// Case III: replace the action
+	replacement, err := testutil.SignedTransfer(addr3, priKey1, uint64(1), big.NewInt(10), []byte{}, uint64(10000), big.NewInt(110))
+	require.NoError(err)
+	require.NoError(ap.Add(ctx, replacement))
+	require.Equal(uint64(2), ap.GetSize())
+	_, err = ap.GetActionByHash(tsf1.Hash())
+	require.Equal(action.ErrNotFound, errors.Cause(err))
+	act, err := ap.GetActionByHash(replacement.Hash())
+	require.NoError(err)
+	require.Equal(replacement, act)
+	// tsf2 to addr2 is still pending
+	require.Equal(1, len(ap.accountDesActs[addr2]))
+	_, ok = ap.accountDesActs[addr2][tsf1.Hash()]
+	require.False(ok)
+	require.Equal(1, len(ap.accountDesActs[addr3]))
+	gas, err := tsf2.IntrinsicGas()
+	require.NoError(err)
+	replacementGas, err := replacement.IntrinsicGas()
+	require.NoError(err)
+	require.Equal(gas+replacementGas, ap.GetGasSize())
+	pNonce, _ := ap.getPendingNonce(addr1)
+	require.Equal(uint64(3), pNonce)
+	pBalance, _ = ap.getPendingBalance(addr1)
+	require.Equal(uint64(10000000-1100000-1000000-30), pBalance.Uint64())
+}
+
+func TestActPool_ReplaceActionGasLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	require := require.New(t)
+	sf := mock_chainmanager.NewMockStateReader(ctrl)
+	sf.EXPECT().State(gomock.Any(), gomock.Any()).DoAndReturn(func(account interface{}, opts ...protocol.StateOption) (uint64, error) {
+		acct, ok := account.(*state.Account)
+		require.True(ok)
+		acct.Nonce = 0
+		acct.Balance = big.NewInt(100000000)
+		return 0, nil
+	}).AnyTimes()
+	apConfig := getActPoolCfg()
+	apConfig.GasPriceBumpPct = 10
+	apConfig.MaxGasLimitPerPool = 30000
+	Ap, err := NewActPool(sf, apConfig, EnableExperimentalActions())
+	require.NoError(err)
+	ap, ok := Ap.(*actPool)
+	require.True(ok)
+	ctx := context.Background()
+
+	tsf1, err := testutil.SignedTransfer(addr3, priKey1, uint64(1), big.NewInt(10), []byte{}, uint64(10000), big.NewInt(100))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr3, priKey2, uint64(1), big.NewInt(10), []byte{}, uint64(10000), big.NewInt(1))
+	require.NoError(err)
+	require.NoError(ap.Add(ctx, tsf1))
+	require.NoError(ap.Add(ctx, tsf2))
+	require.Equal(uint64(20000), ap.GetGasSize())
+
+	// Case I: the replacement taking more gas space evicts the lower-priced action of other account
+	exec1, err := testutil.SignedExecution(addr3, priKey1, uint64(1), big.NewInt(0), uint64(100000), big.NewInt(110), make([]byte, 200))
+	require.NoError(err)
+	require.NoError(ap.Add(ctx, exec1))
+	require.Equal(uint64(30000), ap.GetGasSize())
+	require.Equal(uint64(1), ap.GetSize())
+	_, err = ap.GetActionByHash(tsf2.Hash())
+	require.Equal(action.ErrNotFound, errors.Cause(err))
+
+	// Case II: the replacement is rejected if there is no room for its gas
+	exec2, err := testutil.SignedExecution(addr3, priKey1, uint64(1), big.NewInt(0), uint64(100000), big.NewInt(121), make([]byte, 201))
+	require.NoError(err)
+	require.Equal(action.ErrActPool, errors.Cause(ap.Add(ctx, exec2)))
+	require.Equal(uint64(30000), ap.GetGasSize())
+	act, err := ap.GetActionByHash(exec1.Hash())
+	require.NoError(err)
+	require.Equal(exec1, act)
+
+	// Case III: the replacement taking no more gas space needs no room
+	tsf3, err := testutil.SignedTransfer(addr3, priKey1, uint64(1), big.NewInt(10), []byte{}, uint64(10000), big.NewInt(121))
+	require.NoError(err)
+	require.NoError(ap.Add(ctx, tsf3))
+	require.Equal(uint64(10000), ap.GetGasSize())
+}
+
+func TestActPool_Journal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	require := require.New(t)
+	confirmedNonce := uint64(0)
+	sf := mock_chainmanager.NewMockStateReader(ctrl)
+	sf.EXPECT().State(gomock.Any(), gomock.Any()).DoAndReturn(func(account interface{}, opts ...protocol.StateOption) (uint64, error) {
+		acct, ok := account.(*state.Account)
+		require.True(ok)
+		acct.Nonce = confirmedNonce
+		acct.Balance = big.NewInt(100)
+		return 0, nil
+	}).AnyTimes()
+	testPath, err := testutil.PathOfTempFile("actpool-journal")
+	require.NoError(err)
+	defer testutil.CleanupPath(t, testPath)
+	dbCfg := config.Default.DB
+	dbCfg.DbPath = testPath
+	ctx := context.Background()
+
+	Ap, err := NewActPool(sf, getActPoolCfg(), EnableJournal(db.NewBoltDB(dbCfg)))
+	require.NoError(err)
+	require.NoError(Ap.Start(ctx))
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr2, priKey1, uint64(2), big.NewInt(20), []byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	tsf3, err := testutil.SignedTransfer(addr1, priKey2, uint64(1), big.NewInt(30), []byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	require.NoError(Ap.Add(ctx, tsf1))
+	require.NoError(Ap.Add(ctx, tsf2))
+	require.NoError(Ap.Add(ctx, tsf3))
+	require.NoError(Ap.Stop(ctx))
+
+	// Restart after tsf1 and tsf3 are confirmed
+	confirmedNonce = 1
+	Ap, err = NewActPool(sf, getActPoolCfg(), EnableJournal(db.NewBoltDB(dbCfg)))
+	require.NoError(err)
+	require.NoError(Ap.Start(ctx))
+	require.Equal(uint64(1), Ap.GetSize())
+	act, err := Ap.GetActionByHash(tsf2.Hash())
+	require.NoError(err)
+	require.Equal(tsf2.Hash(), act.Hash())
+	pNonce, err := Ap.GetPendingNonce(addr1)
+	require.NoError(err)
+	require.Equal(uint64(3), pNonce)
+
+	// Confirmed action is removed from journal upon receiving block
+	confirmedNonce = 2
+	require.NoError(Ap.ReceiveBlock(nil))
+	require.Equal(uint64(0), Ap.GetSize())
+	ap, ok := Ap.(*actPool)
+	require.True(ok)
+	acts, err := ap.journal.Actions()
+	require.NoError(err)
+	require.Equal(0, len(acts))
+	require.NoError(Ap.Stop(ctx))
+}
+
+func TestActPool_EvictByGasPrice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	require := require.New(t)
+	sf := mock_chainmanager.NewMockStateReader(ctrl)
+	sf.EXPECT().State(gomock.Any(), gomock.Any()).DoAndReturn(func(account interface{}, opts ...protocol.StateOption) (uint64, error) {
+		acct, ok := account.(*state.Account)
+		require.True(ok)
+		acct.Nonce = 0
+		acct.Balance = big.NewInt(10000000)
+		return 0, nil
+	}).AnyTimes()
+	apConfig := getActPoolCfg()
+	apConfig.MaxNumActsPerPool = 2
+	Ap, err := NewActPool(sf, apConfig, EnableExperimentalActions())
+	require.NoError(err)
+	ap, ok := Ap.(*actPool)
+	require.True(ok)
+	ctx := context.Background()
+
+	tsf1, err := testutil.SignedTransfer(addr3, priKey1, uint64(1), big.NewInt(10), []byte{}, uint64(10000), big.NewInt(1))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr3, priKey1, uint64(2), big.NewInt(10), []byte{}, uint64(10000), big.NewInt(1))
+	require.NoError(err)
+	require.NoError(ap.Add(ctx, tsf1))
+	require.NoError(ap.Add(ctx, tsf2))
+
+	// Case I: gas price is not higher than any tail action
+	tsf3, err := testutil.SignedTransfer(addr3, priKey2, uint64(1), big.NewInt(10), []byte{}, uint64(10000), big.NewInt(1))
+	require.NoError(err)
+	require.Equal(action.ErrActPool, errors.Cause(ap.Add(ctx, tsf3)))
+	// Case II: evict the tail action of addr1
+	tsf4, err := testutil.SignedTransfer(addr3, priKey2, uint64(1), big.NewInt(10), []byte{}, uint64(10000), big.NewInt(2))
+	require.NoError(err)
+	require.NoError(ap.Add(ctx, tsf4))
+	require.Equal(uint64(2), ap.GetSize())
+	_, err = ap.GetActionByHash(tsf2.Hash())
+	require.Equal(action.ErrNotFound, errors.Cause(err))
+	require.Equal(2, ap.priceIndex.Len())
+	pNonce, _ := ap.getPendingNonce(addr1)
+	require.Equal(uint64(2), pNonce)
+	pBalance, _ := ap.getPendingBalance(addr1)
+	require.Equal(uint64(10000000-10010), pBalance.Uint64())
+	// Case III: evict the last action of addr1
+	tsf5, err := testutil.SignedTransfer(addr3, priKey2, uint64(2), big.NewInt(10), []byte{}, uint64(10000), big.NewInt(3))
+	require.NoError(err)
+	require.NoError(ap.Add(ctx, tsf5))
+	require.Equal(uint64(2), ap.GetSize())
+	_, ok = ap.accountActs[addr1]
+	require.False(ok)
+	pNonce, _ = ap.getPendingNonce(addr2)
+	require.Equal(uint64(3), pNonce)
+	// Case IV: actions of the same account are not evicted
+	tsf6, err := testutil.SignedTransfer(addr3, priKey2, uint64(3), big.NewInt(10), []byte{}, uint64(10000), big.NewInt(4))
+	require.NoError(err)
+	require.Equal(action.ErrActPool, errors.Cause(ap.Add(ctx, tsf6)))
+	// Case V: the action failing admission checks does not evict others
+	tsf7, err := testutil.SignedTransfer(addr3, priKey3, ap.cfg.MaxNumActsPerAcct+1, big.NewInt(10), []byte{}, uint64(10000), big.NewInt(100))
+	require.NoError(err)
+	require.Equal(action.ErrNonce, errors.Cause(ap.Add(ctx, tsf7)))
+	tsf8, err := testutil.SignedTransfer(addr3, priKey3, uint64(1), big.NewInt(10), []byte{}, uint64(10000), big.NewInt(10000))
+	require.NoError(err)
+	require.Equal(action.ErrBalance, errors.Cause(ap.Add(ctx, tsf8)))
+	require.Equal(uint64(2), ap.GetSize())
+	_, err = ap.GetActionByHash(tsf5.Hash())
+	require.NoError(err)
+	_, ok = ap.accountActs[addr3]
+	require.False(ok)
+}
+
+func TestActPool_RateLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	require := require.New(t)
+	sf := mock_chainmanager.NewMockStateReader(ctrl)
+	sf.EXPECT().State(gomock.Any(), gomock.Any()).DoAndReturn(func(account interface{}, opts ...protocol.StateOption) (uint64, error) {
+		acct, ok := account.(*state.Account)
+		require.True(ok)
+		acct.Nonce = 0
+		acct.Balance = big.NewInt(100)
+		return 0, nil
+	}).AnyTimes()
+	apConfig := getActPoolCfg()
+	apConfig.EnableRateLimit = true
+	apConfig.RateLimit = config.ActPoolRateLimit{
+		AccountAvg:   1,
+		AccountBurst: 2,
+		PeerAvg:      1,
+		PeerBurst:    3,
+	}
+	Ap, err := NewActPool(sf, apConfig)
+	require.NoError(err)
+	ctx := WithPeer(context.Background(), "peer")
+
+	for i := uint64(1); i <= 3; i++ {
+		tsf, err := testutil.SignedTransfer(addr2, priKey1, i, big.NewInt(1), []byte{}, uint64(100000), big.NewInt(0))
+		require.NoError(err)
+		err = Ap.Add(ctx, tsf)
+		if i <= 2 {
+			require.NoError(err)
+		} else {
+			require.Equal(action.ErrActPool, errors.Cause(err))
+			require.Contains(err.Error(), "too many actions from account")
+		}
+	}
+	// the peer has used up its tokens
+	tsf, err := testutil.SignedTransfer(addr1, priKey2, uint64(1), big.NewInt(1), []byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	err = Ap.Add(ctx, tsf)
+	require.Equal(action.ErrActPool, errors.Cause(err))
+	require.Contains(err.Error(), "too many actions from peer")
+	// actions not received from peers are only limited per account
+	require.NoError(Ap.Add(context.Background(), tsf))
+	require.Equal(uint64(3), Ap.GetSize())
+}
+
+func TestActPool_BlackList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	require := require.New(t)
+	sf := mock_chainmanager.NewMockStateReader(ctrl)
+	sf.EXPECT().State(gomock.Any(), gomock.Any()).DoAndReturn(func(account interface{}, opts ...protocol.StateOption) (uint64, error) {
+		acct, ok := account.(*state.Account)
+		require.True(ok)
+		acct.Nonce = 0
+		acct.Balance = big.NewInt(100)
+		return 0, nil
+	}).AnyTimes()
+	Ap, err := NewActPool(sf, getActPoolCfg())
+	require.NoError(err)
+	ctx := context.Background()
+	require.Equal([]string{addr6}, Ap.BlackList())
+
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, uint64(1), big.NewInt(1), []byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr2, priKey1, uint64(2), big.NewInt(1), []byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	require.NoError(Ap.Add(ctx, tsf1))
+
+	// pending actions are removed once the sender is blacklisted
+	Ap.AddToBlackList(addr1)
+	require.Equal(uint64(0), Ap.GetSize())
+	require.Equal(uint64(0), Ap.GetGasSize())
+	require.Equal(action.ErrAddress, errors.Cause(Ap.Add(ctx, tsf2)))
+	require.ElementsMatch([]string{addr1, addr6}, Ap.BlackList())
+
+	Ap.RemoveFromBlackList(addr1)
+	Ap.RemoveFromBlackList(addr6)
+	require.Equal([]string{}, Ap.BlackList())
+	require.NoError(Ap.Add(ctx, tsf1))
+	require.NoError(Ap.Add(ctx, tsf2))
+}
+
+func TestActPool_DeleteAction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	require := require.New(t)
+	sf := mock_chainmanager.NewMockStateReader(ctrl)
+	sf.EXPECT().State(gomock.Any(), gomock.Any()).DoAndReturn(func(account interface{}, opts ...protocol.StateOption) (uint64, error) {
+		acct, ok := account.(*state.Account)
+		require.True(ok)
+		acct.Nonce = 0
+		acct.Balance = big.NewInt(100)
+		return 0, nil
+	}).AnyTimes()
+	Ap, err := NewActPool(sf, getActPoolCfg())
+	require.NoError(err)
+	ctx := context.Background()
+
+	callers := []address.Address{identityset.Address(28), identityset.Address(29), identityset.Address(30)}
+	for i, sk := range []crypto.PrivateKey{priKey1, priKey2, priKey3} {
+		for nonce := uint64(1); nonce <= 3; nonce++ {
+			tsf, err := testutil.SignedTransfer(callers[(i+1)%len(callers)].String(), sk, nonce, big.NewInt(1), []byte{}, uint64(100000), big.NewInt(0))
+			require.NoError(err)
+			require.NoError(Ap.Add(ctx, tsf))
+		}
+	}
+	require.Equal(uint64(9), Ap.GetSize())
+
+	// deleting the actions of accounts is safe along with the readers of pool
+	var wg sync.WaitGroup
+	for _, caller := range callers {
+		wg.Add(2)
+		go func(caller address.Address) {
+			defer wg.Done()
+			Ap.DeleteAction(caller)
+		}(caller)
+		go func() {
+			defer wg.Done()
+			Ap.PendingActionMap()
+			Ap.GetSize()
+		}()
+	}
+	wg.Wait()
+	require.Equal(uint64(0), Ap.GetSize())
+	require.Equal(uint64(0), Ap.GetGasSize())
+	require.Empty(Ap.PendingActionMap())
+}
+
+type actionEventCollector struct {
+	events chan *ActionEvent
+}
+
+func (c *actionEventCollector) ReceiveActionEvent(evt *ActionEvent) error {
+	c.events <- evt
+	return nil
+}
+
+func TestActPool_ActionEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	require := require.New(t)
+	confirmedNonce := uint64(0)
+	sf := mock_chainmanager.NewMockStateReader(ctrl)
+	sf.EXPECT().State(gomock.Any(), gomock.Any()).DoAndReturn(func(account interface{}, opts ...protocol.StateOption) (uint64, error) {
+		acct, ok := account.(*state.Account)
+		require.True(ok)
+		acct.Nonce = confirmedNonce
+		acct.Balance = big.NewInt(10000000)
+		return 0, nil
+	}).AnyTimes()
+	Ap, err := NewActPool(sf, getActPoolCfg())
+	require.NoError(err)
+	ctx := context.Background()
+	collector := &actionEventCollector{events: make(chan *ActionEvent, 10)}
+	require.NoError(Ap.AddActionEventSubscriber(collector))
+	require.Error(Ap.AddActionEventSubscriber(collector))
+	defer func() {
+		require.NoError(Ap.RemoveActionEventSubscriber(collector))
+		require.Error(Ap.RemoveActionEventSubscriber(collector))
+	}()
+	nextEvent := func() *ActionEvent {
+		select {
+		case evt := <-collector.events:
+			return evt
+		case <-time.After(time.Second):
+			require.FailNow("timeout when waiting for action event")
+		}
+		return nil
+	}
+
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, uint64(1), big.NewInt(10), []byte{}, uint64(10000), big.NewInt(100))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr2, priKey1, uint64(2), big.NewInt(10), []byte{}, uint64(10000), big.NewInt(100))
+	require.NoError(err)
+	replacement, err := testutil.SignedTransfer(addr3, priKey1, uint64(1), big.NewInt(10), []byte{}, uint64(10000), big.NewInt(200))
+	require.NoError(err)
+	require.NoError(Ap.Add(ctx, tsf1))
+	require.NoError(Ap.Add(ctx, tsf2))
+	for _, act := range []action.SealedEnvelope{tsf1, tsf2} {
+		evt := nextEvent()
+		require.Equal(ActionAdded, evt.Type)
+		require.Equal(act.Hash(), evt.Hash)
+		require.Equal(addr1, evt.Sender)
+	}
+
+	require.NoError(Ap.Add(ctx, replacement))
+	evt := nextEvent()
+	require.Equal(ActionReplaced, evt.Type)
+	require.Equal(replacement.Hash(), evt.Hash)
+	require.Equal(tsf1.Hash(), evt.Replaced)
+	require.Equal(ReplaceReasonGasPrice, evt.Reason)
+
+	confirmedNonce = 1
+	require.NoError(Ap.ReceiveBlock(nil))
+	evt = nextEvent()
+	require.Equal(ActionConfirmed, evt.Type)
+	require.Equal(replacement.Hash(), evt.Hash)
+
+	Ap.AddToBlackList(addr1)
+	evt = nextEvent()
+	require.Equal(ActionDropped, evt.Type)
+	require.Equal(tsf2.Hash(), evt.Hash)
+	require.Equal(DropReasonBlackListed, evt.Reason)
+}
+
 func TestActPool_PickActs(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -859,6 +1315,63 @@ func TestActPool_GetPendingNonce(t *testing.T) {
 	require.Equal(uint64(2), nonce)
 }
 
+func TestActPool_GetPendingAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	require := require.New(t)
+	sf := mock_chainmanager.NewMockStateReader(ctrl)
+	// Create actpool
+	apConfig := getActPoolCfg()
+	Ap, err := NewActPool(sf, apConfig, EnableExperimentalActions())
+	require.NoError(err)
+	ap, ok := Ap.(*actPool)
+	require.True(ok)
+	ap.AddActionEnvelopeValidators(protocol.NewGenericValidator(sf, accountutil.AccountState))
+
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr2, priKey1, uint64(2), big.NewInt(20), []byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	tsf4, err := testutil.SignedTransfer(addr2, priKey1, uint64(4), big.NewInt(30), []byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	tsf7, err := testutil.SignedTransfer(addr2, priKey1, uint64(7), big.NewInt(40), []byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	sf.EXPECT().State(gomock.Any(), gomock.Any()).DoAndReturn(func(account interface{}, opts ...protocol.StateOption) (uint64, error) {
+		acct, ok := account.(*state.Account)
+		require.True(ok)
+		acct.Nonce = 0
+		acct.Balance = big.NewInt(100)
+		return 0, nil
+	}).AnyTimes()
+
+	for _, tsf := range []action.SealedEnvelope{tsf1, tsf2, tsf4, tsf7} {
+		require.NoError(ap.Add(context.Background(), tsf))
+	}
+
+	// account without actions in pool
+	pa, err := ap.GetPendingAccount(addr2)
+	require.NoError(err)
+	require.Equal(uint64(1), pa.PendingNonce)
+	require.Equal(big.NewInt(100), pa.PendingBalance)
+	require.Empty(pa.Executable)
+	require.Empty(pa.Queued)
+	require.Empty(pa.NonceGaps)
+
+	pa, err = ap.GetPendingAccount(addr1)
+	require.NoError(err)
+	require.Equal(uint64(0), pa.ConfirmedNonce)
+	require.Equal(big.NewInt(100), pa.ConfirmedBalance)
+	require.Equal(uint64(3), pa.PendingNonce)
+	require.Equal(big.NewInt(70), pa.PendingBalance)
+	require.Equal([]action.SealedEnvelope{tsf1, tsf2}, pa.Executable)
+	require.Equal([]action.SealedEnvelope{tsf4, tsf7}, pa.Queued)
+	require.Equal([]uint64{3, 5, 6}, pa.NonceGaps)
+	// the view is a copy
+	pa.PendingBalance.SetUint64(0)
+	balance := ap.accountActs[addr1].PendingBalance()
+	require.Equal(big.NewInt(70), balance)
+}
+
 func TestActPool_GetUnconfirmedActs(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -52,6 +52,7 @@ func (h *noncePriorityQueue) Pop() interface{} {
 type ActQueue interface {
 	Overlaps(action.SealedEnvelope) bool
 	Put(action.SealedEnvelope) error
+	Replace(action.SealedEnvelope) (action.SealedEnvelope, error)
 	FilterNonce(uint64) []action.SealedEnvelope
 	UpdateQueue(uint64) []action.SealedEnvelope
 	SetPendingNonce(uint64)
@@ -62,6 +63,8 @@ type ActQueue interface {
 	Empty() bool
 	PendingActs() []action.SealedEnvelope
 	AllActs() []action.SealedEnvelope
+	Tail() (action.SealedEnvelope, bool)
+	PopTail() (action.SealedEnvelope, bool)
 }
 
 // actQueue is a queue of actions from an account
@@ -76,7 +79,9 @@ type actQueue struct {
 	pendingNonce uint64
 	// Current pending balance for the account
 	pendingBalance *big.Int
-	ttl            time.Duration
+	// The highest nonce in queue
+	tail uint64
+	ttl  time.Duration
 }
 
 // ActQueueOption is the option for actQueue.
@@ -113,11 +118,31 @@ func (q *actQueue) Put(act action.SealedEnvelope) error {
 	if _, exist := q.items[nonce]; exist {
 		return errors.Wrap(action.ErrNonce, "duplicate nonce")
 	}
+	if q.Len() == 0 || nonce > q.tail {
+		q.tail = nonce
+	}
 	heap.Push(&q.index, nonceWithTTL{nonce: nonce, deadline: time.Now().Add(q.ttl)})
 	q.items[nonce] = act
 	return nil
 }
 
+// Replace swaps the action having the same nonce with the given one, and returns the replaced action
+func (q *actQueue) Replace(act action.SealedEnvelope) (action.SealedEnvelope, error) {
+	nonce := act.Nonce()
+	old, exist := q.items[nonce]
+	if !exist {
+		return action.SealedEnvelope{}, errors.Wrapf(action.ErrNonce, "nonce %d does not exist", nonce)
+	}
+	for i := range q.index {
+		if q.index[i].nonce == nonce {
+			q.index[i].deadline = time.Now().Add(q.ttl)
+			break
+		}
+	}
+	q.items[nonce] = act
+	return old, nil
+}
+
 // FilterNonce removes all actions from the map with a nonce lower than the given threshold
 func (q *actQueue) FilterNonce(threshold uint64) []action.SealedEnvelope {
 	var removed []action.SealedEnvelope
@@ -127,6 +152,7 @@ func (q *actQueue) FilterNonce(threshold uint64) []action.SealedEnvelope {
 		removed = append(removed, q.items[nonce])
 		delete(q.items, nonce)
 	}
+	q.updateTail()
 	return removed
 }
 
@@ -140,6 +166,7 @@ func (q *actQueue) cleanTimeout() []action.SealedEnvelope {
 			q.index = append(q.index[:i], q.index[i+1:]...)
 		}
 	}
+	q.updateTail()
 	return removedFromQueue
 }
 
@@ -258,6 +285,46 @@ func (q *actQueue) AllActs() []action.SealedEnvelope {
 	return acts
 }
 
+// Tail returns the action with the highest nonce in queue
+func (q *actQueue) Tail() (action.SealedEnvelope, bool) {
+	if q.Len() == 0 {
+		return action.SealedEnvelope{}, false
+	}
+	return q.items[q.tail], true
+}
+
+// PopTail removes the action with the highest nonce from queue
+func (q *actQueue) PopTail() (action.SealedEnvelope, bool) {
+	if q.Len() == 0 {
+		return action.SealedEnvelope{}, false
+	}
+	for i := range q.index {
+		if q.index[i].nonce == q.tail {
+			heap.Remove(&q.index, i)
+			break
+		}
+	}
+	act := q.items[q.tail]
+	delete(q.items, q.tail)
+	q.updateTail()
+	return act, true
+}
+
+// updateTail moves the tail down to the highest nonce remaining in queue after actions are removed. The nonces in queue
+// are within MaxNumActsPerAcct from the confirmed nonce, which bounds the steps
+func (q *actQueue) updateTail() {
+	if q.Len() == 0 {
+		q.tail = 0
+		return
+	}
+	for {
+		if _, exist := q.items[q.tail]; exist {
+			return
+		}
+		q.tail--
+	}
+}
+
 // removeActs removes all the actions starting at idx from queue
 func (q *actQueue) removeActs(idx int) []action.SealedEnvelope {
 	removedFromQueue := make([]action.SealedEnvelope, 0)
@@ -267,6 +334,7 @@ func (q *actQueue) removeActs(idx int) []action.SealedEnvelope {
 	}
 	q.index = q.index[:idx]
 	heap.Init(&q.index)
+	q.updateTail()
 	return removedFromQueue
 }
 
@@ -76,6 +76,60 @@ func TestActQueuePut(t *testing.T) {
 	require.Error(q.Put(tsf3))
 }
 
+func TestActQueueReplace(t *testing.T) {
+	require := require.New(t)
+	q := NewActQueue(nil, "").(*actQueue)
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, 1, big.NewInt(100), nil, uint64(0), big.NewInt(0))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr2, priKey1, 1, big.NewInt(100), nil, uint64(0), big.NewInt(10))
+	require.NoError(err)
+	_, err = q.Replace(tsf2)
+	require.Error(err)
+	require.NoError(q.Put(tsf1))
+	old, err := q.Replace(tsf2)
+	require.NoError(err)
+	require.Equal(tsf1, old)
+	require.Equal(tsf2, q.items[uint64(1)])
+	require.Equal(1, q.index.Len())
+}
+
+func TestActQueueTail(t *testing.T) {
+	require := require.New(t)
+	q := NewActQueue(nil, "").(*actQueue)
+	_, ok := q.Tail()
+	require.False(ok)
+	_, ok = q.PopTail()
+	require.False(ok)
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, 3, big.NewInt(100), nil, uint64(0), big.NewInt(0))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr2, priKey1, 1, big.NewInt(100), nil, uint64(0), big.NewInt(0))
+	require.NoError(err)
+	tsf3, err := testutil.SignedTransfer(addr2, priKey1, 2, big.NewInt(100), nil, uint64(0), big.NewInt(0))
+	require.NoError(err)
+	require.NoError(q.Put(tsf1))
+	require.NoError(q.Put(tsf2))
+	require.NoError(q.Put(tsf3))
+	tail, ok := q.Tail()
+	require.True(ok)
+	require.Equal(tsf1, tail)
+	tail, ok = q.PopTail()
+	require.True(ok)
+	require.Equal(tsf1, tail)
+	require.Equal(2, q.Len())
+	tail, ok = q.PopTail()
+	require.True(ok)
+	require.Equal(tsf3, tail)
+	require.Equal(uint64(1), q.index[0].nonce)
+	// the tail is updated after actions are removed
+	require.NoError(q.Put(tsf1))
+	tail, ok = q.Tail()
+	require.True(ok)
+	require.Equal(tsf1, tail)
+	require.Equal(2, len(q.FilterNonce(4)))
+	_, ok = q.Tail()
+	require.False(ok)
+}
+
 func TestActQueueFilterNonce(t *testing.T) {
 	require := require.New(t)
 	q := NewActQueue(nil, "").(*actQueue)
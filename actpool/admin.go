@@ -0,0 +1,52 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package actpool
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/iotexproject/iotex-address/address"
+)
+
+// BlackListController controls the actpool blacklist at runtime
+type BlackListController struct {
+	ap ActPool
+}
+
+// NewBlackListController constructs a blacklist controller instance
+func NewBlackListController(ap ActPool) *BlackListController {
+	return &BlackListController{
+		ap: ap,
+	}
+}
+
+// Handle handles admin request, e.g., /actpool/blacklist?add=io1... or /actpool/blacklist?remove=io1...
+func (c *BlackListController) Handle(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if addr := query.Get("add"); addr != "" {
+		if _, err := address.FromString(addr); err != nil {
+			http.Error(w, "invalid address "+addr, http.StatusBadRequest)
+			return
+		}
+		c.ap.AddToBlackList(addr)
+	}
+	if addr := query.Get("remove"); addr != "" {
+		if _, err := address.FromString(addr); err != nil {
+			http.Error(w, "invalid address "+addr, http.StatusBadRequest)
+			return
+		}
+		c.ap.RemoveFromBlackList(addr)
+	}
+	type payload struct {
+		BlackList []string `json:"blackList"`
+	}
+	enc := json.NewEncoder(w)
+	if err := enc.Encode(&payload{BlackList: c.ap.BlackList()}); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package actpool
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/test/mock/mock_chainmanager"
+)
+
+func TestBlackListController(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	require := require.New(t)
+	sf := mock_chainmanager.NewMockStateReader(ctrl)
+	ap, err := NewActPool(sf, getActPoolCfg())
+	require.NoError(err)
+	c := NewBlackListController(ap)
+
+	w := httptest.NewRecorder()
+	c.Handle(w, httptest.NewRequest(http.MethodGet, "/actpool/blacklist", nil))
+	require.Equal(http.StatusOK, w.Code)
+	require.JSONEq(`{"blackList":["`+addr6+`"]}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	c.Handle(w, httptest.NewRequest(http.MethodGet, "/actpool/blacklist?add="+addr1, nil))
+	require.Equal(http.StatusOK, w.Code)
+	require.ElementsMatch([]string{addr1, addr6}, ap.BlackList())
+
+	w = httptest.NewRecorder()
+	c.Handle(w, httptest.NewRequest(http.MethodGet, "/actpool/blacklist?remove="+addr6, nil))
+	require.Equal(http.StatusOK, w.Code)
+	require.JSONEq(`{"blackList":["`+addr1+`"]}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	c.Handle(w, httptest.NewRequest(http.MethodGet, "/actpool/blacklist?add=invalid", nil))
+	require.Equal(http.StatusBadRequest, w.Code)
+	require.Equal([]string{addr1}, ap.BlackList())
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package actpool
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/db/batch"
+)
+
+const (
+	// actJournalNS is the bucket name for journaled actions
+	actJournalNS = "ActJournal"
+)
+
+// actJournal persists the actions accepted by actpool, so that they can be recovered after restart
+type actJournal struct {
+	kvStore db.KVStore
+}
+
+func newActJournal(kv db.KVStore) *actJournal {
+	return &actJournal{kvStore: kv}
+}
+
+// Start starts the journal
+func (j *actJournal) Start(ctx context.Context) error {
+	return j.kvStore.Start(ctx)
+}
+
+// Stop stops the journal
+func (j *actJournal) Stop(ctx context.Context) error {
+	return j.kvStore.Stop(ctx)
+}
+
+// Put records an action
+func (j *actJournal) Put(act action.SealedEnvelope) error {
+	actHash := act.Hash()
+	data, err := proto.Marshal(act.Proto())
+	if err != nil {
+		return errors.Wrapf(err, "failed to serialize action %x", actHash)
+	}
+	return j.kvStore.Put(actJournalNS, actHash[:], data)
+}
+
+// Actions returns all the recorded actions
+func (j *actJournal) Actions() ([]action.SealedEnvelope, error) {
+	_, values, err := j.kvStore.Filter(actJournalNS, func(k, v []byte) bool { return true }, nil, nil)
+	if err != nil {
+		if errors.Cause(err) == db.ErrNotExist || errors.Cause(err) == db.ErrBucketNotExist {
+			return nil, nil
+		}
+		return nil, err
+	}
+	acts := make([]action.SealedEnvelope, 0, len(values))
+	for _, v := range values {
+		pb := &iotextypes.Action{}
+		if err := proto.Unmarshal(v, pb); err != nil {
+			return nil, errors.Wrap(err, "failed to deserialize journaled action")
+		}
+		var act action.SealedEnvelope
+		if err := act.LoadProto(pb); err != nil {
+			return nil, errors.Wrap(err, "failed to load journaled action")
+		}
+		acts = append(acts, act)
+	}
+	return acts, nil
+}
+
+// Compact removes the recorded actions which no longer exist in the given pool
+func (j *actJournal) Compact(pool map[hash.Hash256]action.SealedEnvelope) error {
+	keys, _, err := j.kvStore.Filter(actJournalNS, func(k, v []byte) bool {
+		_, exist := pool[hash.BytesToHash256(k)]
+		return !exist
+	}, nil, nil)
+	if err != nil {
+		if errors.Cause(err) == db.ErrNotExist || errors.Cause(err) == db.ErrBucketNotExist {
+			return nil
+		}
+		return err
+	}
+	b := batch.NewBatch()
+	for _, k := range keys {
+		b.Delete(actJournalNS, k, "failed to delete journaled action %x", k)
+	}
+	return j.kvStore.WriteBatch(b)
+}
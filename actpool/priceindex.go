@@ -0,0 +1,156 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package actpool
+
+import (
+	"container/heap"
+	"math/big"
+
+	"github.com/iotexproject/go-pkgs/hash"
+
+	"github.com/iotexproject/iotex-core/action"
+)
+
+// pricedAction is the tail action of an account in the price index
+type pricedAction struct {
+	sender   string
+	act      action.SealedEnvelope
+	hash     hash.Hash256
+	gasPrice *big.Int
+	index    int
+}
+
+// priceQueue is a priority queue of the tail actions of all accounts in pool, ordered by gas price ascending, and
+// nonce descending for actions with the same gas price
+type priceQueue []*pricedAction
+
+func (pq priceQueue) Len() int { return len(pq) }
+
+func (pq priceQueue) Less(i, j int) bool {
+	if c := pq[i].gasPrice.Cmp(pq[j].gasPrice); c != 0 {
+		return c < 0
+	}
+	return pq[i].act.Nonce() > pq[j].act.Nonce()
+}
+
+func (pq priceQueue) Swap(i, j int) {
+	pq[i], pq[j] = pq[j], pq[i]
+	pq[i].index = i
+	pq[j].index = j
+}
+
+func (pq *priceQueue) Push(x interface{}) {
+	in, ok := x.(*pricedAction)
+	if !ok {
+		return
+	}
+	in.index = len(*pq)
+	*pq = append(*pq, in)
+}
+
+func (pq *priceQueue) Pop() interface{} {
+	old := *pq
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = nil
+	x.index = -1
+	*pq = old[0 : n-1]
+	return x
+}
+
+// priceIndex indexes the tail actions of accounts by gas price, which are the candidates to evict when pool is full,
+// and the arrival order of all actions in pool
+type priceIndex struct {
+	queue    priceQueue
+	tails    map[string]*pricedAction
+	arrivals map[hash.Hash256]uint64
+	count    uint64
+}
+
+func newPriceIndex() *priceIndex {
+	return &priceIndex{
+		queue:    priceQueue{},
+		tails:    make(map[string]*pricedAction),
+		arrivals: make(map[hash.Hash256]uint64),
+	}
+}
+
+// Add records the arrival order of an action
+func (pi *priceIndex) Add(actHash hash.Hash256) {
+	if _, exist := pi.arrivals[actHash]; exist {
+		return
+	}
+	pi.arrivals[actHash] = pi.count
+	pi.count++
+}
+
+// Remove removes the arrival order of an action
+func (pi *priceIndex) Remove(actHash hash.Hash256) {
+	delete(pi.arrivals, actHash)
+}
+
+// Arrival returns the order in which the action is added into pool
+func (pi *priceIndex) Arrival(actHash hash.Hash256) (uint64, bool) {
+	arrival, exist := pi.arrivals[actHash]
+	return arrival, exist
+}
+
+// Len returns the number of indexed actions
+func (pi *priceIndex) Len() int {
+	return len(pi.arrivals)
+}
+
+// SetTail sets the tail action of an account
+func (pi *priceIndex) SetTail(sender string, tail action.SealedEnvelope) {
+	if item, exist := pi.tails[sender]; exist {
+		item.act = tail
+		item.hash = tail.Hash()
+		item.gasPrice = tail.GasPrice()
+		heap.Fix(&pi.queue, item.index)
+		return
+	}
+	item := &pricedAction{
+		sender:   sender,
+		act:      tail,
+		hash:     tail.Hash(),
+		gasPrice: tail.GasPrice(),
+	}
+	heap.Push(&pi.queue, item)
+	pi.tails[sender] = item
+}
+
+// DeleteTail removes the tail action of an account
+func (pi *priceIndex) DeleteTail(sender string) {
+	item, exist := pi.tails[sender]
+	if !exist {
+		return
+	}
+	heap.Remove(&pi.queue, item.index)
+	delete(pi.tails, sender)
+}
+
+// LowestPriced returns the lowest-priced tail action of the accounts other than the excluded one, if its gas price is
+// lower than the given threshold
+func (pi *priceIndex) LowestPriced(threshold *big.Int, exclude string) *pricedAction {
+	if pi.queue.Len() == 0 {
+		return nil
+	}
+	item := pi.queue[0]
+	if item.sender == exclude {
+		// the lowest-priced one of other accounts is a child of the root
+		item = nil
+		for i := 1; i <= 2 && i < pi.queue.Len(); i++ {
+			if item == nil || pi.queue.Less(i, item.index) {
+				item = pi.queue[i]
+			}
+		}
+	}
+	if item == nil || item.gasPrice.Cmp(threshold) >= 0 {
+		return nil
+	}
+	return item
+}
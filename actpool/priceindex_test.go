@@ -0,0 +1,66 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package actpool
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestPriceIndex(t *testing.T) {
+	require := require.New(t)
+	pi := newPriceIndex()
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, 1, big.NewInt(100), nil, uint64(0), big.NewInt(3))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr2, priKey1, 2, big.NewInt(100), nil, uint64(0), big.NewInt(1))
+	require.NoError(err)
+	tsf3, err := testutil.SignedTransfer(addr1, priKey2, 1, big.NewInt(100), nil, uint64(0), big.NewInt(2))
+	require.NoError(err)
+	tsf4, err := testutil.SignedTransfer(addr1, priKey3, 1, big.NewInt(100), nil, uint64(0), big.NewInt(4))
+	require.NoError(err)
+
+	// arrival order
+	for _, tsf := range []action.SealedEnvelope{tsf1, tsf2, tsf3, tsf3} {
+		pi.Add(tsf.Hash())
+	}
+	require.Equal(3, pi.Len())
+	arrival, ok := pi.Arrival(tsf3.Hash())
+	require.True(ok)
+	require.Equal(uint64(2), arrival)
+	pi.Remove(tsf3.Hash())
+	_, ok = pi.Arrival(tsf3.Hash())
+	require.False(ok)
+	require.Equal(2, pi.Len())
+
+	// tail actions
+	require.Nil(pi.LowestPriced(big.NewInt(10), ""))
+	pi.SetTail("a", tsf1)
+	pi.SetTail("b", tsf3)
+	pi.SetTail("c", tsf4)
+	require.Equal(tsf3.Hash(), pi.LowestPriced(big.NewInt(10), "").hash)
+	require.Nil(pi.LowestPriced(big.NewInt(2), ""))
+	// the lowest-priced tail of other accounts
+	require.Equal(tsf1.Hash(), pi.LowestPriced(big.NewInt(10), "b").hash)
+	require.Equal(tsf3.Hash(), pi.LowestPriced(big.NewInt(10), "a").hash)
+	// update the tail of an account
+	pi.SetTail("a", tsf2)
+	item := pi.LowestPriced(big.NewInt(10), "")
+	require.Equal("a", item.sender)
+	require.Equal(tsf2.Hash(), item.hash)
+	require.Equal(tsf3.Hash(), pi.LowestPriced(big.NewInt(10), "a").hash)
+	pi.DeleteTail("a")
+	pi.DeleteTail("a")
+	require.Equal(tsf4.Hash(), pi.LowestPriced(big.NewInt(10), "b").hash)
+	pi.DeleteTail("b")
+	require.Nil(pi.LowestPriced(big.NewInt(10), "c"))
+	require.Equal(1, pi.queue.Len())
+}
@@ -0,0 +1,24 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package actpool
+
+import (
+	"context"
+)
+
+type peerContextKey struct{}
+
+// WithPeer adds the id of the peer which an action is received from to context
+func WithPeer(ctx context.Context, peer string) context.Context {
+	return context.WithValue(ctx, peerContextKey{}, peer)
+}
+
+// GetPeer returns the id of the peer which an action is received from
+func GetPeer(ctx context.Context) (string, bool) {
+	peer, ok := ctx.Value(peerContextKey{}).(string)
+	return peer, ok && peer != ""
+}
@@ -0,0 +1,25 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package actpool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerContext(t *testing.T) {
+	require := require.New(t)
+	_, ok := GetPeer(context.Background())
+	require.False(ok)
+	_, ok = GetPeer(WithPeer(context.Background(), ""))
+	require.False(ok)
+	peer, ok := GetPeer(WithPeer(context.Background(), "peer"))
+	require.True(ok)
+	require.Equal("peer", peer)
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"encoding/hex"
+
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/go-pkgs/cache"
+
+	"github.com/iotexproject/iotex-core/actpool"
+	"github.com/iotexproject/iotex-core/api/apipb"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+type (
+	// ActionListener passes the events of pending actions to all responders
+	ActionListener interface {
+		Start() error
+		Stop() error
+		ReceiveActionEvent(*actpool.ActionEvent) error
+		AddResponder(ActionResponder) error
+	}
+
+	// actionListener implements the ActionListener interface
+	actionListener struct {
+		streamMap *cache.ThreadSafeLruCache // all registered <ActionResponder, struct{}>
+	}
+
+	// pendingActionListener defines the pending action listener in subscribed through API
+	pendingActionListener struct {
+		addresses map[string]bool
+		stream    apipb.APIService_StreamPendingActionsServer
+		errChan   chan error
+	}
+)
+
+// NewActionListener returns a new actpool action listener
+func NewActionListener() ActionListener {
+	return &actionListener{
+		streamMap: cache.NewThreadSafeLruCache(0),
+	}
+}
+
+// Start starts the action listener
+func (al *actionListener) Start() error {
+	return nil
+}
+
+// Stop stops the action listener
+func (al *actionListener) Stop() error {
+	// notify all responders to exit
+	al.streamMap.Range(func(key cache.Key, _ interface{}) bool {
+		r, ok := key.(ActionResponder)
+		if !ok {
+			log.S().Panic("streamMap stores a key which is not an ActionResponder")
+		}
+		r.Exit()
+		al.streamMap.Remove(key)
+		return true
+	})
+	return nil
+}
+
+// ReceiveActionEvent handles the event of pending action
+func (al *actionListener) ReceiveActionEvent(evt *actpool.ActionEvent) error {
+	// pass the event to every responder
+	al.streamMap.Range(func(key cache.Key, _ interface{}) bool {
+		r, ok := key.(ActionResponder)
+		if !ok {
+			log.S().Panic("streamMap stores a key which is not an ActionResponder")
+		}
+		if err := r.Respond(evt); err != nil {
+			al.streamMap.Remove(key)
+		}
+		return true
+	})
+	return nil
+}
+
+// AddResponder adds a new responder
+func (al *actionListener) AddResponder(r ActionResponder) error {
+	_, loaded := al.streamMap.Get(r)
+	if loaded {
+		return errorResponderAdded
+	}
+	al.streamMap.Add(r, struct{}{})
+	return nil
+}
+
+// NewPendingActionListener returns a new pending action listener, which only responds to the actions sent from or
+// to the given addresses, or all actions if no address is given
+func NewPendingActionListener(addresses []string, stream apipb.APIService_StreamPendingActionsServer, errChan chan error) ActionResponder {
+	addrs := make(map[string]bool)
+	for _, addr := range addresses {
+		addrs[addr] = true
+	}
+	return &pendingActionListener{
+		addresses: addrs,
+		stream:    stream,
+		errChan:   errChan,
+	}
+}
+
+// Respond to the event of pending action
+func (pl *pendingActionListener) Respond(evt *actpool.ActionEvent) error {
+	if !pl.match(evt) {
+		return nil
+	}
+	res := &apipb.StreamPendingActionsResponse{
+		Event: &apipb.PendingActionEvent{
+			Type:    convertActionEventType(evt.Type),
+			ActHash: hex.EncodeToString(evt.Hash[:]),
+			Action:  evt.Action.Proto(),
+			Sender:  evt.Sender,
+			Reason:  evt.Reason,
+		},
+	}
+	if evt.Type == actpool.ActionReplaced {
+		res.Event.ReplacedActHash = hex.EncodeToString(evt.Replaced[:])
+	}
+	// send the event thru streaming API
+	if err := pl.stream.Send(res); err != nil {
+		log.L().Info(
+			"Error when streaming the pending action",
+			zap.String("actHash", res.Event.ActHash),
+			zap.Error(err),
+		)
+		pl.errChan <- err
+		return err
+	}
+	return nil
+}
+
+// Exit send to error channel
+func (pl *pendingActionListener) Exit() {
+	pl.errChan <- nil
+}
+
+func (pl *pendingActionListener) match(evt *actpool.ActionEvent) bool {
+	if len(pl.addresses) == 0 || pl.addresses[evt.Sender] {
+		return true
+	}
+	dst, ok := evt.Action.Destination()
+	return ok && pl.addresses[dst]
+}
+
+func convertActionEventType(t actpool.ActionEventType) apipb.PendingActionEventType {
+	switch t {
+	case actpool.ActionReplaced:
+		return apipb.PendingActionEventType_REPLACED
+	case actpool.ActionDropped:
+		return apipb.PendingActionEventType_DROPPED
+	case actpool.ActionConfirmed:
+		return apipb.PendingActionEventType_CONFIRMED
+	default:
+		return apipb.PendingActionEventType_ADDED
+	}
+}
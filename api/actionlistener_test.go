@@ -0,0 +1,96 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/actpool"
+	"github.com/iotexproject/iotex-core/api/apipb"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_apiresponder"
+	"github.com/iotexproject/iotex-core/test/mock/mock_apiserver"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestActionListener(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	require := require.New(t)
+
+	responder := mock_apiresponder.NewMockActionResponder(ctrl)
+	listener := NewActionListener()
+	require.NoError(listener.Start())
+	require.NoError(listener.Stop())
+
+	require.NoError(listener.AddResponder(responder))
+	require.Equal(errorResponderAdded, listener.AddResponder(responder))
+
+	evt := &actpool.ActionEvent{Type: actpool.ActionAdded}
+	responder.EXPECT().Respond(evt).Return(nil).Times(1)
+	require.NoError(listener.ReceiveActionEvent(evt))
+
+	// the responder is removed once it fails to respond
+	responder.EXPECT().Respond(evt).Return(errors.New("Error when streaming the pending action")).Times(1)
+	require.NoError(listener.ReceiveActionEvent(evt))
+	require.NoError(listener.ReceiveActionEvent(evt))
+
+	responder.EXPECT().Exit().Return().Times(1)
+	require.NoError(listener.AddResponder(responder))
+	require.NoError(listener.Stop())
+}
+
+func TestPendingActionListener(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	require := require.New(t)
+
+	sender := identityset.Address(28).String()
+	recipient := identityset.Address(29).String()
+	tsf, err := testutil.SignedTransfer(recipient, identityset.PrivateKey(28), 1, big.NewInt(10), nil, 10000, big.NewInt(0))
+	require.NoError(err)
+	evt := &actpool.ActionEvent{
+		Type:     actpool.ActionReplaced,
+		Action:   tsf,
+		Hash:     tsf.Hash(),
+		Sender:   sender,
+		Reason:   actpool.ReplaceReasonGasPrice,
+		Replaced: tsf.Hash(),
+	}
+	errChan := make(chan error, 10)
+	server := mock_apiserver.NewMockStreamPendingActionsServer(ctrl)
+
+	// actions not related to the addresses are filtered out
+	responder := NewPendingActionListener([]string{identityset.Address(30).String()}, server, errChan)
+	require.NoError(responder.Respond(evt))
+
+	responder = NewPendingActionListener([]string{recipient}, server, errChan)
+	server.EXPECT().Send(gomock.Any()).DoAndReturn(func(res *apipb.StreamPendingActionsResponse) error {
+		h := tsf.Hash()
+		require.Equal(apipb.PendingActionEventType_REPLACED, res.GetEvent().GetType())
+		require.Equal(hex.EncodeToString(h[:]), res.GetEvent().GetActHash())
+		require.Equal(hex.EncodeToString(h[:]), res.GetEvent().GetReplacedActHash())
+		require.Equal(sender, res.GetEvent().GetSender())
+		require.Equal(actpool.ReplaceReasonGasPrice, res.GetEvent().GetReason())
+		return nil
+	}).Times(1)
+	require.NoError(responder.Respond(evt))
+
+	server.EXPECT().Send(gomock.Any()).Return(errorSend).Times(1)
+	require.Equal(errorSend, responder.Respond(evt))
+
+	responder.Exit()
+
+	require.Equal(errorSend, <-errChan)
+	require.NoError(<-errChan)
+}
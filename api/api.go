@@ -9,14 +9,21 @@ package api
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 	"math"
 	"math/big"
 	"net"
+	"reflect"
+	"sort"
 	"strconv"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
@@ -37,9 +44,12 @@ import (
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
 	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
 	"github.com/iotexproject/iotex-core/action/protocol/poll"
 	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/action/protocol/staking"
 	"github.com/iotexproject/iotex-core/actpool"
+	"github.com/iotexproject/iotex-core/api/apipb"
 	logfilter "github.com/iotexproject/iotex-core/api/logfilter"
 	"github.com/iotexproject/iotex-core/blockchain"
 	"github.com/iotexproject/iotex-core/blockchain/block"
@@ -49,6 +59,7 @@ import (
 	"github.com/iotexproject/iotex-core/blocksync"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/db/trie"
 	"github.com/iotexproject/iotex-core/gasstation"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/pkg/version"
@@ -72,6 +83,8 @@ type BroadcastOutbound func(ctx context.Context, chainID uint32, msg proto.Messa
 type Config struct {
 	broadcastHandler  BroadcastOutbound
 	electionCommittee committee.Committee
+	transferIndexer   blockindex.TransferIndexer
+	tokenIndexer      blockindex.TokenIndexer
 }
 
 // Option is the option to override the api config
@@ -93,6 +106,38 @@ func WithNativeElection(committee committee.Committee) Option {
 	}
 }
 
+// WithTransferIndexer is the option to query native token transfers by address through API
+func WithTransferIndexer(transferIndexer blockindex.TransferIndexer) Option {
+	return func(cfg *Config) error {
+		cfg.transferIndexer = transferIndexer
+		return nil
+	}
+}
+
+// WithTokenIndexer is the option to query XRC20 and XRC721 token transfers and holders through API
+func WithTokenIndexer(tokenIndexer blockindex.TokenIndexer) Option {
+	return func(cfg *Config) error {
+		cfg.tokenIndexer = tokenIndexer
+		return nil
+	}
+}
+
+// historyViewReader is a history state reader with the view of a protocol at the same height, rather than the view
+// of tip
+type historyViewReader struct {
+	protocol.StateReader
+	name string
+	view interface{}
+}
+
+// ReadView returns the view at the height for the protocol, and the view of tip for others
+func (r *historyViewReader) ReadView(name string) (interface{}, error) {
+	if name == r.name {
+		return r.view, nil
+	}
+	return r.StateReader.ReadView(name)
+}
+
 // Server provides api for user to query blockchain data
 type Server struct {
 	bc                blockchain.Blockchain
@@ -107,9 +152,16 @@ type Server struct {
 	cfg               config.Config
 	registry          *protocol.Registry
 	chainListener     Listener
+	actionListener    ActionListener
 	grpcServer        *grpc.Server
+	web3Server        *Web3Server
+	graphQLServer     *GraphQLServer
+	websocketServer   *WebsocketServer
 	hasActionIndex    bool
+	rl                *rateLimiter
 	electionCommittee committee.Committee
+	transferIndexer   blockindex.TransferIndexer
+	tokenIndexer      blockindex.TokenIndexer
 }
 
 // NewServer creates a new server
@@ -132,7 +184,7 @@ func NewServer(
 		}
 	}
 
-	if cfg.API == (config.API{}) {
+	if reflect.DeepEqual(cfg.API, config.API{}) {
 		log.L().Warn("API server is not configured.")
 		cfg.API = config.Default.API
 	}
@@ -153,67 +205,134 @@ func NewServer(
 		cfg:               cfg,
 		registry:          registry,
 		chainListener:     NewChainListener(),
-		gs:                gasstation.NewGasStation(chain, sf.SimulateExecution, dao, cfg.API),
+		actionListener:    NewActionListener(),
+		gs:                gasstation.NewGasStation(chain, sf.SimulateExecution, dao, cfg.API, gasstation.WithActPool(actPool)),
 		electionCommittee: apiCfg.electionCommittee,
+		transferIndexer:   apiCfg.transferIndexer,
+		tokenIndexer:      apiCfg.tokenIndexer,
 	}
 	if _, ok := cfg.Plugins[config.GatewayPlugin]; ok {
 		svr.hasActionIndex = true
 	}
+	streamInterceptors := []grpc.StreamServerInterceptor{grpc_prometheus.StreamServerInterceptor}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{grpc_prometheus.UnaryServerInterceptor}
+	if cfg.API.RateLimit.Enabled {
+		svr.rl = newRateLimiter(cfg.API.RateLimit, svr.rangeCost)
+		streamInterceptors = append(streamInterceptors, svr.rl.StreamServerInterceptor)
+		unaryInterceptors = append(unaryInterceptors, svr.rl.UnaryServerInterceptor)
+	}
 	svr.grpcServer = grpc.NewServer(
-		grpc.StreamInterceptor(grpc_prometheus.StreamServerInterceptor),
-		grpc.UnaryInterceptor(grpc_prometheus.UnaryServerInterceptor),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
 	)
 	iotexapi.RegisterAPIServiceServer(svr.grpcServer, svr)
+	apipb.RegisterAPIServiceServer(svr.grpcServer, svr)
 	grpc_prometheus.Register(svr.grpcServer)
 	reflection.Register(svr.grpcServer)
+	if cfg.API.Web3Port != 0 {
+		svr.web3Server = NewWeb3Server(svr, cfg.API.Web3Port)
+	}
+	if cfg.API.GraphQLPort != 0 {
+		graphQLServer, err := NewGraphQLServer(svr, cfg.API.GraphQLPort)
+		if err != nil {
+			return nil, err
+		}
+		svr.graphQLServer = graphQLServer
+	}
+	if cfg.API.WebsocketPort != 0 {
+		svr.websocketServer = NewWebsocketServer(svr, cfg.API.WebsocketPort)
+	}
 
 	return svr, nil
 }
 
 // GetAccount returns the metadata of an account
 func (api *Server) GetAccount(ctx context.Context, in *iotexapi.GetAccountRequest) (*iotexapi.GetAccountResponse, error) {
-	if in.Address == address.RewardingPoolAddr || in.Address == address.StakingBucketPoolAddr {
-		return api.getProtocolAccount(ctx, in.Address)
-	}
+	return api.getAccount(ctx, in.Address, 0)
+}
 
-	state, tipHeight, err := accountutil.AccountStateWithHeight(api.sf, in.Address)
-	if err != nil {
-		return nil, status.Error(codes.NotFound, err.Error())
+// GetAccountAtHeight returns the metadata of an account at the height, archive mode is required for the height lower
+// than tip height
+func (api *Server) GetAccountAtHeight(ctx context.Context, in *apipb.GetAccountAtHeightRequest) (*iotexapi.GetAccountResponse, error) {
+	return api.getAccount(ctx, in.GetAddress(), in.GetHeight())
+}
+
+// getAccount returns the metadata of an account at the height, 0 means the tip height
+func (api *Server) getAccount(ctx context.Context, encodedAddr string, height uint64) (*iotexapi.GetAccountResponse, error) {
+	if height != 0 && height == api.bc.TipHeight() {
+		height = 0
+	}
+	if encodedAddr == address.RewardingPoolAddr || encodedAddr == address.StakingBucketPoolAddr {
+		return api.getProtocolAccount(ctx, encodedAddr, height)
 	}
-	pendingNonce, err := api.ap.GetPendingNonce(in.Address)
+
+	accountMeta, stateHeight, err := api.accountState(encodedAddr, height)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 	if api.indexer == nil {
 		return nil, status.Error(codes.NotFound, blockindex.ErrActionIndexNA.Error())
 	}
-	addr, err := address.FromString(in.Address)
+	addr, err := address.FromString(encodedAddr)
 	if err != nil {
 		return nil, err
 	}
-	numActions, err := api.indexer.GetActionCountByAddress(hash.BytesToHash160(addr.Bytes()))
+	addrHash := hash.BytesToHash160(addr.Bytes())
+	numActions, err := api.indexer.GetActionCountByAddress(addrHash)
 	if err != nil {
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
-	accountMeta := &iotextypes.AccountMeta{
-		Address:      in.Address,
-		Balance:      state.Balance.String(),
-		Nonce:        state.Nonce,
-		PendingNonce: pendingNonce,
-		NumActions:   numActions,
-		IsContract:   state.IsContract(),
+	if height != 0 {
+		// the actions of the address in the blocks up to the height
+		hashesAt := func(start, count uint64) ([][]byte, error) {
+			return api.indexer.GetActionsByAddress(addrHash, start, count)
+		}
+		if numActions, err = api.searchActionIndex(numActions, hashesAt, stateHeight+1); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
 	}
-	header, err := api.bc.BlockHeaderByHeight(tipHeight)
+	accountMeta.NumActions = numActions
+	header, err := api.bc.BlockHeaderByHeight(stateHeight)
 	if err != nil {
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
 	hash := header.HashBlock()
 	return &iotexapi.GetAccountResponse{AccountMeta: accountMeta, BlockIdentifier: &iotextypes.BlockIdentifier{
 		Hash:   hex.EncodeToString(hash[:]),
-		Height: tipHeight,
+		Height: stateHeight,
 	}}, nil
 }
 
+// accountState returns the metadata of an account at the height other than the number of actions, which requires the
+// action index, and the height of the state. 0 means the tip height
+func (api *Server) accountState(encodedAddr string, height uint64) (*iotextypes.AccountMeta, uint64, error) {
+	var sr protocol.StateReader = api.sf
+	if height != 0 {
+		var err error
+		if sr, err = api.historyStateReader(height); err != nil {
+			return nil, 0, err
+		}
+	}
+	state, stateHeight, err := accountutil.AccountStateWithHeight(sr, encodedAddr)
+	if err != nil {
+		return nil, 0, status.Error(codes.NotFound, err.Error())
+	}
+	var pendingNonce uint64
+	if height != 0 {
+		// the pending actions are on top of the tip state
+		pendingNonce = state.Nonce + 1
+	} else if pendingNonce, err = api.ap.GetPendingNonce(encodedAddr); err != nil {
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+	return &iotextypes.AccountMeta{
+		Address:      encodedAddr,
+		Balance:      state.Balance.String(),
+		Nonce:        state.Nonce,
+		PendingNonce: pendingNonce,
+		IsContract:   state.IsContract(),
+	}, stateHeight, nil
+}
+
 // GetActions returns actions
 func (api *Server) GetActions(ctx context.Context, in *iotexapi.GetActionsRequest) (*iotexapi.GetActionsResponse, error) {
 	if (!api.hasActionIndex || api.indexer == nil) && (in.GetByHash() != nil || in.GetByAddr() != nil) {
@@ -393,7 +512,9 @@ func (api *Server) SendAction(ctx context.Context, in *iotexapi.SendActionReques
 	return &iotexapi.SendActionResponse{ActionHash: hex.EncodeToString(hash[:])}, nil
 }
 
-// GetReceiptByAction gets receipt with corresponding action hash
+// GetReceiptByAction gets receipt with corresponding action hash. The receipt is read from the chain db, so its
+// revert message is only the one kept in consensus after Hawaii height; the revert reason decoded before Hawaii
+// height is only returned for executions run by the node, e.g. ReadContract and EstimateActionGasConsumption
 func (api *Server) GetReceiptByAction(ctx context.Context, in *iotexapi.GetReceiptByActionRequest) (*iotexapi.GetReceiptByActionResponse, error) {
 	if !api.hasActionIndex || api.indexer == nil {
 		return nil, status.Error(codes.NotFound, blockindex.ErrActionIndexNA.Error())
@@ -412,7 +533,7 @@ func (api *Server) GetReceiptByAction(ctx context.Context, in *iotexapi.GetRecei
 	}
 	return &iotexapi.GetReceiptByActionResponse{
 		ReceiptInfo: &iotexapi.ReceiptInfo{
-			Receipt: receipt.ConvertToReceiptPb(),
+			Receipt: toReceiptPb(receipt),
 			BlkHash: hex.EncodeToString(blkHash[:]),
 		},
 	}, nil
@@ -422,41 +543,613 @@ func (api *Server) GetReceiptByAction(ctx context.Context, in *iotexapi.GetRecei
 func (api *Server) ReadContract(ctx context.Context, in *iotexapi.ReadContractRequest) (*iotexapi.ReadContractResponse, error) {
 	log.L().Debug("receive read smart contract request")
 
-	sc := &action.Execution{}
-	if err := sc.LoadProto(in.Execution); err != nil {
+	return api.readContract(in.Execution, in.CallerAddress, 0, nil)
+}
+
+// ReadContractAtHeight reads contract on the state at the height, archive mode is required for the height lower than
+// tip height
+func (api *Server) ReadContractAtHeight(ctx context.Context, in *apipb.ReadContractAtHeightRequest) (*iotexapi.ReadContractResponse, error) {
+	return api.readContract(in.GetExecution(), in.GetCallerAddress(), in.GetHeight(), nil)
+}
+
+// GetUnverifiedAccountProof is an experimental api, which returns the merkle proof of an account in the state trie. No
+// field of the block header commits to the root hash of the state trie, so the proof is not anchored in the header
+// returned along with it, and is only as trustworthy as the node
+func (api *Server) GetUnverifiedAccountProof(ctx context.Context, in *apipb.GetUnverifiedAccountProofRequest) (*apipb.GetUnverifiedAccountProofResponse, error) {
+	addr, err := address.FromString(in.GetAddress())
+	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	res, _, err := api.accountProof(addr, in.GetHeight())
+	return res, err
+}
 
-	state, err := accountutil.AccountState(api.sf, in.CallerAddress)
+// GetUnverifiedStorageProof is an experimental api, which returns the merkle proofs of the storage slots of a contract,
+// together with the unverified proof of the contract account
+func (api *Server) GetUnverifiedStorageProof(ctx context.Context, in *apipb.GetUnverifiedStorageProofRequest) (*apipb.GetUnverifiedStorageProofResponse, error) {
+	addr, err := address.FromString(in.GetAddress())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	keys := make([]hash.Hash256, 0, len(in.GetKeys()))
+	for _, k := range in.GetKeys() {
+		slot, err := decodeStorageSlot(k)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		keys = append(keys, hash.BytesToHash256(slot[:]))
+	}
+	accountProof, account, err := api.accountProof(addr, in.GetHeight())
+	if err != nil {
+		return nil, err
+	}
+	var sr protocol.StateReader = api.sf
+	if height := accountProof.GetProof().GetHeight(); height != api.bc.TipHeight() {
+		sr = factory.NewHistoryStateReader(api.sf, height)
+	}
+	addrHash := hash.BytesToHash160(addr.Bytes())
+	res := &apipb.GetUnverifiedStorageProofResponse{
+		AccountProof:  accountProof,
+		StorageProofs: make([]*apipb.StorageProof, 0, len(keys)),
+	}
+	for i, key := range keys {
+		proof, err := evm.GetStorageProof(sr, addrHash, key)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		value, err := evm.VerifyStorageProof(account.Root, addrHash, key, proof)
+		if err != nil && errors.Cause(err) != trie.ErrNotExist {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res.StorageProofs = append(res.StorageProofs, &apipb.StorageProof{
+			Key:   in.GetKeys()[i],
+			Value: value,
+			Proof: proof,
+		})
+	}
+	return res, nil
+}
 
-	sc, _ = action.NewExecution(
-		sc.Contract(),
-		state.Nonce+1,
-		sc.Amount(),
-		api.cfg.Genesis.BlockGasLimit,
-		big.NewInt(0),
-		sc.Data(),
+// GetActionProof returns an action and its receipt with the merkle proofs against the transaction root and receipt
+// root of the block header, together with the endorsements of the block
+func (api *Server) GetActionProof(ctx context.Context, in *apipb.GetActionProofRequest) (*apipb.GetActionProofResponse, error) {
+	if !api.hasActionIndex || api.indexer == nil {
+		return nil, status.Error(codes.NotFound, blockindex.ErrActionIndexNA.Error())
+	}
+	actHash, err := hash.HexStringToHash256(in.GetActionHash())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	actIndex, err := api.indexer.GetActionIndex(actHash[:])
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	blk, err := api.dao.GetBlockByHeight(actIndex.BlockHeight())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if blk.Receipts, err = api.dao.GetReceipts(actIndex.BlockHeight()); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	footer, err := blk.ConvertToBlockFooterPb()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res := &apipb.GetActionProofResponse{
+		BlockHeader: blk.ConvertToBlockHeaderPb(),
+		BlockFooter: footer,
+	}
+	for i, selp := range blk.Actions {
+		if selp.Hash() != actHash {
+			continue
+		}
+		proof, err := blk.ActionProof(i)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res.Action = selp.Proto()
+		res.ActionIndex = uint32(i)
+		res.ActionProof = hashesToBytes(proof)
+		break
+	}
+	for i, receipt := range blk.Receipts {
+		if receipt.ActionHash != actHash {
+			continue
+		}
+		proof, err := blk.ReceiptProof(i)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res.Receipt = toReceiptPb(receipt)
+		res.ReceiptIndex = uint32(i)
+		res.ReceiptProof = hashesToBytes(proof)
+		break
+	}
+	if res.Action == nil || res.Receipt == nil {
+		return nil, status.Errorf(codes.NotFound, "action %x is not found in block %d", actHash, blk.Height())
+	}
+	return res, nil
+}
+
+// GetTransfersByAddress returns the native token transfers from or to an address
+func (api *Server) GetTransfersByAddress(ctx context.Context, in *apipb.GetTransfersByAddressRequest) (*apipb.GetTransfersByAddressResponse, error) {
+	if api.transferIndexer == nil {
+		return nil, status.Error(codes.Unavailable, "transfer indexer is not enabled")
+	}
+	addrHash, err := api.checkRangeQuery(in.GetAddress(), in.GetCount())
+	if err != nil {
+		return nil, err
+	}
+	total, err := api.transferIndexer.GetTransferCountByAddress(addrHash)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res := &apipb.GetTransfersByAddressResponse{Total: total}
+	if in.GetStart() >= total {
+		return res, nil
+	}
+	transfers, err := api.transferIndexer.GetTransfersByAddress(addrHash, in.GetStart(), in.GetCount())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	for _, t := range transfers {
+		res.Transfers = append(res.Transfers, &apipb.Transfer{
+			BlkHeight:  t.BlockHeight,
+			ActionHash: hex.EncodeToString(t.ActionHash[:]),
+			Type:       t.Type,
+			Amount:     t.Amount.String(),
+			Sender:     t.Sender,
+			Recipient:  t.Recipient,
+		})
+	}
+	return res, nil
+}
+
+// GetTokenTransfersByAddress returns the XRC20 and XRC721 token transfers from or to an address
+func (api *Server) GetTokenTransfersByAddress(ctx context.Context, in *apipb.GetTokenTransfersRequest) (*apipb.GetTokenTransfersResponse, error) {
+	if api.tokenIndexer == nil {
+		return nil, status.Error(codes.Unavailable, "token indexer is not enabled")
+	}
+	return api.tokenTransfers(in, api.tokenIndexer.GetTokenTransferCountByAddress, api.tokenIndexer.GetTokenTransfersByAddress)
+}
+
+// GetTokenTransfersByToken returns the transfers of a XRC20 or XRC721 token
+func (api *Server) GetTokenTransfersByToken(ctx context.Context, in *apipb.GetTokenTransfersRequest) (*apipb.GetTokenTransfersResponse, error) {
+	if api.tokenIndexer == nil {
+		return nil, status.Error(codes.Unavailable, "token indexer is not enabled")
+	}
+	return api.tokenTransfers(in, api.tokenIndexer.GetTokenTransferCountByToken, api.tokenIndexer.GetTokenTransfersByToken)
+}
+
+// GetTokenHolders returns the holders of a XRC20 or XRC721 token with their balances
+func (api *Server) GetTokenHolders(ctx context.Context, in *apipb.GetTokenHoldersRequest) (*apipb.GetTokenHoldersResponse, error) {
+	if api.tokenIndexer == nil {
+		return nil, status.Error(codes.Unavailable, "token indexer is not enabled")
+	}
+	token, err := api.checkRangeQuery(in.GetToken(), in.GetCount())
+	if err != nil {
+		return nil, err
+	}
+	total, err := api.tokenIndexer.GetTokenHolderCount(token)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res := &apipb.GetTokenHoldersResponse{Total: total}
+	if in.GetStart() >= total {
+		return res, nil
+	}
+	holders, err := api.tokenIndexer.GetTokenHolders(token, in.GetStart(), in.GetCount())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	for _, h := range holders {
+		res.Holders = append(res.Holders, &apipb.TokenHolder{
+			Address: h.Address,
+			Balance: h.Balance.String(),
+		})
+	}
+	return res, nil
+}
+
+func (api *Server) tokenTransfers(
+	in *apipb.GetTokenTransfersRequest,
+	countFunc func(hash.Hash160) (uint64, error),
+	transfersFunc func(hash.Hash160, uint64, uint64) ([]*blockindex.TokenTransfer, error),
+) (*apipb.GetTokenTransfersResponse, error) {
+	addr, err := api.checkRangeQuery(in.GetAddress(), in.GetCount())
+	if err != nil {
+		return nil, err
+	}
+	total, err := countFunc(addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res := &apipb.GetTokenTransfersResponse{Total: total}
+	if in.GetStart() >= total {
+		return res, nil
+	}
+	transfers, err := transfersFunc(addr, in.GetStart(), in.GetCount())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	for _, t := range transfers {
+		res.Transfers = append(res.Transfers, &apipb.TokenTransfer{
+			BlkHeight:  t.BlockHeight,
+			ActionHash: hex.EncodeToString(t.ActionHash[:]),
+			Token:      t.Token,
+			Sender:     t.Sender,
+			Recipient:  t.Recipient,
+			Amount:     t.Amount.String(),
+			Xrc721:     t.XRC721,
+		})
+	}
+	return res, nil
+}
+
+// GetPendingAccount returns the pending view of an account with its actions in actpool
+func (api *Server) GetPendingAccount(ctx context.Context, in *apipb.GetPendingAccountRequest) (*apipb.GetPendingAccountResponse, error) {
+	if _, err := address.FromString(in.GetAddress()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	pa, err := api.ap.GetPendingAccount(in.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	res := &apipb.GetPendingAccountResponse{
+		Address:          in.GetAddress(),
+		ConfirmedNonce:   pa.ConfirmedNonce,
+		ConfirmedBalance: pa.ConfirmedBalance.String(),
+		PendingNonce:     pa.PendingNonce,
+		PendingBalance:   pa.PendingBalance.String(),
+		NonceGaps:        pa.NonceGaps,
+	}
+	for _, selp := range pa.Executable {
+		act, err := api.pendingAction(selp)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res.ExecutableActions = append(res.ExecutableActions, act)
+	}
+	for _, selp := range pa.Queued {
+		act, err := api.pendingAction(selp)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res.QueuedActions = append(res.QueuedActions, act)
+	}
+	return res, nil
+}
+
+// GetActionsByCursor returns all actions or the actions of an address page by page with an opaque cursor
+func (api *Server) GetActionsByCursor(ctx context.Context, in *apipb.GetActionsByCursorRequest) (*apipb.GetActionsByCursorResponse, error) {
+	if !api.hasActionIndex || api.indexer == nil {
+		return nil, status.Error(codes.NotFound, blockindex.ErrActionIndexNA.Error())
+	}
+	count := in.GetCount()
+	if count == 0 {
+		return nil, status.Error(codes.InvalidArgument, "count must be greater than zero")
+	}
+	if count > api.cfg.API.RangeQueryLimit {
+		return nil, status.Error(codes.InvalidArgument, "range exceeds the limit")
+	}
+	var (
+		total    uint64
+		hashesAt = api.indexer.GetActionHashFromIndex
+		err      error
 	)
+	if in.GetAddress() == "" {
+		total, err = api.indexer.GetTotalActions()
+	} else {
+		addr, e := address.FromString(in.GetAddress())
+		if e != nil {
+			return nil, status.Error(codes.InvalidArgument, e.Error())
+		}
+		addrHash := hash.BytesToHash160(addr.Bytes())
+		total, err = api.indexer.GetActionCountByAddress(addrHash)
+		hashesAt = func(start, count uint64) ([][]byte, error) {
+			return api.indexer.GetActionsByAddress(addrHash, start, count)
+		}
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	callerAddr, err := address.FromString(in.CallerAddress)
+	// the actions in the height range are at the positions [lo, hi) of the index, as the heights are ascending
+	filter := in.GetFilter()
+	lo, hi := uint64(0), total
+	if filter.GetFromHeight() > 0 {
+		if lo, err = api.searchActionIndex(total, hashesAt, filter.GetFromHeight()); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	if filter.GetToHeight() > 0 {
+		if hi, err = api.searchActionIndex(total, hashesAt, filter.GetToHeight()+1); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	newestFirst, step := in.GetNewestFirst(), int64(1)
+	if newestFirst {
+		step = -1
+	}
+	pos, err := decodeActionCursor(in.GetCursor(), newestFirst)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	switch {
+	case newestFirst && (in.GetCursor() == "" || pos >= int64(hi)):
+		pos = int64(hi) - 1
+	case !newestFirst && pos < int64(lo):
+		pos = int64(lo)
+	}
 
-	ctx, err = api.bc.Context()
+	types := make(map[string]bool, len(filter.GetTypes()))
+	for _, t := range filter.GetTypes() {
+		types[t] = true
+	}
+	match := func(selp action.SealedEnvelope) bool {
+		if len(types) > 0 && !types[actionTypeName(selp.Proto().GetCore())] {
+			return false
+		}
+		if cp := filter.GetCounterparty(); cp != "" {
+			sender, _ := address.FromBytes(selp.SrcPubkey().Hash())
+			dest, _ := selp.Destination()
+			return sender.String() == cp || dest == cp
+		}
+		return true
+	}
+
+	// scan at most range query limit actions in a call, and return the cursor to continue if less are matched
+	var (
+		res     = &apipb.GetActionsByCursorResponse{}
+		limit   = api.cfg.API.RangeQueryLimit
+		inRange = func(p int64) bool { return p >= int64(lo) && p < int64(hi) }
+	)
+	for scanned := uint64(0); inRange(pos) && uint64(len(res.ActionInfo)) < count && scanned < limit; {
+		size := int64(count)
+		if remaining := int64(limit - scanned); size > remaining {
+			size = remaining
+		}
+		start, end := pos, pos+size
+		if newestFirst {
+			start, end = pos-size+1, pos+1
+		}
+		if start < int64(lo) {
+			start = int64(lo)
+		}
+		if end > int64(hi) {
+			end = int64(hi)
+		}
+		hashes, err := hashesAt(uint64(start), uint64(end-start))
+		if err != nil {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if len(hashes) == 0 {
+			break
+		}
+		for i := range hashes {
+			h := hashes[i]
+			if newestFirst {
+				h = hashes[len(hashes)-1-i]
+			}
+			pos += step
+			scanned++
+			selp, blkHash, blkHeight, err := api.getActionByActionHash(hash.BytesToHash256(h))
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get action %x in index: %v", h, err)
+			}
+			if !match(selp) {
+				continue
+			}
+			act, err := api.committedAction(selp, blkHash, blkHeight)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			if res.ActionInfo = append(res.ActionInfo, act); uint64(len(res.ActionInfo)) == count {
+				break
+			}
+		}
+	}
+	if inRange(pos) {
+		res.NextCursor = encodeActionCursor(pos, newestFirst)
+	}
+	return res, nil
+}
+
+// searchActionIndex returns the first position in the index of which the action's height is no less than height
+func (api *Server) searchActionIndex(
+	total uint64,
+	hashesAt func(uint64, uint64) ([][]byte, error),
+	height uint64,
+) (uint64, error) {
+	var err error
+	pos := sort.Search(int(total), func(i int) bool {
+		if err != nil {
+			return true
+		}
+		var hashes [][]byte
+		if hashes, err = hashesAt(uint64(i), 1); err != nil {
+			return true
+		}
+		actIndex, e := api.indexer.GetActionIndex(hashes[0])
+		if e != nil {
+			err = e
+			return true
+		}
+		return actIndex.BlockHeight() >= height
+	})
+	return uint64(pos), err
+}
+
+// encodeActionCursor encodes the direction and the next position to read in the action index
+func encodeActionCursor(pos int64, newestFirst bool) string {
+	b := make([]byte, 9)
+	if newestFirst {
+		b[0] = 1
+	}
+	binary.BigEndian.PutUint64(b[1:], uint64(pos))
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeActionCursor(cursor string, newestFirst bool) (int64, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || len(b) != 9 || b[0] > 1 {
+		return 0, errors.New("invalid cursor")
+	}
+	if (b[0] == 1) != newestFirst {
+		return 0, errors.New("cursor is of the other direction")
+	}
+	pos := binary.BigEndian.Uint64(b[1:])
+	if pos > math.MaxInt64 {
+		return 0, errors.New("invalid cursor")
+	}
+	return int64(pos), nil
+}
+
+// actionTypeName returns the name of the action in action core, e.g., transfer, execution, stakeCreate
+func actionTypeName(core *iotextypes.ActionCore) string {
+	m := core.ProtoReflect()
+	if fd := m.WhichOneof(m.Descriptor().Oneofs().ByName("action")); fd != nil {
+		return string(fd.Name())
+	}
+	return ""
+}
+
+// checkRangeQuery checks the count of a range query by address, and returns the hash of the address
+func (api *Server) checkRangeQuery(addrStr string, count uint64) (hash.Hash160, error) {
+	if count == 0 {
+		return hash.ZeroHash160, status.Error(codes.InvalidArgument, "count must be greater than zero")
+	}
+	if count > api.cfg.API.RangeQueryLimit {
+		return hash.ZeroHash160, status.Error(codes.InvalidArgument, "range exceeds the limit")
+	}
+	addr, err := address.FromString(addrStr)
+	if err != nil {
+		return hash.ZeroHash160, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return hash.BytesToHash160(addr.Bytes()), nil
+}
+
+// accountProof returns the proof of the account at the height, 0 means the tip height
+func (api *Server) accountProof(addr address.Address, height uint64) (*apipb.GetUnverifiedAccountProofResponse, *state.Account, error) {
+	var err error
+	if height == 0 || height == api.bc.TipHeight() {
+		// the proof of tip is read from the state trie of the state factory
+		if height, err = api.sf.Height(); err != nil {
+			return nil, nil, status.Error(codes.Internal, err.Error())
+		}
+	} else if err = api.checkHistoryHeight(height); err != nil {
+		return nil, nil, err
+	}
+	addrHash := hash.BytesToHash160(addr.Bytes())
+	proof, err := api.sf.StateProofAtHeight(height, protocol.LegacyKeyOption(addrHash))
+	if err != nil {
+		if cause := errors.Cause(err); cause == factory.ErrNotSupported || cause == factory.ErrNoArchiveData {
+			return nil, nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+	header, err := api.bc.BlockHeaderByHeight(height)
+	if err != nil {
+		return nil, nil, status.Error(codes.NotFound, err.Error())
+	}
+	res := &apipb.GetUnverifiedAccountProofResponse{
+		Proof:       stateProofToPb(proof),
+		BlockHeader: header.BlockHeaderProto(),
+	}
+	account := state.EmptyAccount()
+	value, err := factory.VerifyStateProof(proof.RootHash, factory.AccountKVNamespace, addrHash[:], proof)
+	switch errors.Cause(err) {
+	case nil:
+		if err := state.Deserialize(&account, value); err != nil {
+			return nil, nil, status.Error(codes.Internal, err.Error())
+		}
+		res.Account = value
+	case state.ErrStateNotExist:
+	default:
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+	return res, &account, nil
+}
+
+// ReadContractWithOverride reads contract on the tip state with the accounts and block overridden
+func (api *Server) ReadContractWithOverride(ctx context.Context, in *apipb.CallWithOverrideRequest) (*iotexapi.ReadContractResponse, error) {
+	override, err := stateOverrideFromPb(in.GetOverride())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return api.readContract(in.GetExecution(), in.GetCallerAddress(), 0, override)
+}
+
+// EstimateExecutionGasWithOverride estimates the gas of an execution on the tip state with the accounts and block
+// overridden
+func (api *Server) EstimateExecutionGasWithOverride(ctx context.Context, in *apipb.CallWithOverrideRequest) (*iotexapi.EstimateActionGasConsumptionResponse, error) {
+	override, err := stateOverrideFromPb(in.GetOverride())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return api.estimateActionGasConsumptionForExecution(in.GetExecution(), in.GetCallerAddress(), override)
+}
+
+func (api *Server) readContract(exec *iotextypes.Execution, callerAddress string, height uint64, override *evm.StateOverride) (*iotexapi.ReadContractResponse, error) {
+	sc := &action.Execution{}
+	if err := sc.LoadProto(exec); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	callerAddr, err := address.FromString(callerAddress)
 	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var (
+		ctx context.Context
+		sr  protocol.StateReader = api.sf
+		// ws is the working set at the height, the simulation runs on the tip if it is nil
+		ws protocol.StateManager
+	)
+	if height != 0 && height != api.bc.TipHeight() {
+		if ctx, ws, err = api.workingSetAtHeight(height); err != nil {
+			return nil, err
+		}
+		sr = ws
+	} else if ctx, err = api.bc.Context(); err != nil {
 		return nil, err
 	}
-	retval, receipt, err := api.sf.SimulateExecution(ctx, callerAddr, sc, api.dao.GetBlockHash)
+	if override != nil {
+		ctx = evm.WithStateOverrideCtx(ctx, override)
+	}
+
+	nonce, err := simulationNonce(sr, callerAddress, override)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	sc, _ = action.NewExecution(
+		sc.Contract(),
+		nonce,
+		sc.Amount(),
+		api.cfg.Genesis.BlockGasLimit,
+		big.NewInt(0),
+		sc.Data(),
+	)
+
+	var (
+		retval  []byte
+		receipt *action.Receipt
+	)
+	if ws != nil {
+		retval, receipt, err = evm.SimulateExecution(ctx, ws, callerAddr, sc, api.dao.GetBlockHash)
+	} else {
+		retval, receipt, err = api.sf.SimulateExecution(ctx, callerAddr, sc, api.dao.GetBlockHash)
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	// the revert reason is returned in the execution revert message of receipt
 	return &iotexapi.ReadContractResponse{
 		Data:    hex.EncodeToString(retval),
-		Receipt: receipt.ConvertToReceiptPb(),
+		Receipt: toReceiptPb(receipt),
 	}, nil
 }
 
@@ -466,34 +1159,187 @@ func (api *Server) ReadState(ctx context.Context, in *iotexapi.ReadStateRequest)
 	if !ok {
 		return nil, status.Errorf(codes.Internal, "protocol %s isn't registered", string(in.ProtocolID))
 	}
-	data, readStateHeight, err := api.readState(ctx, p, in.GetHeight(), in.MethodName, in.Arguments...)
+	data, readStateHeight, err := api.readState(ctx, p, in.GetHeight(), in.MethodName, in.Arguments...)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	blkHash, err := api.dao.GetBlockHash(readStateHeight)
+	if err != nil {
+		if errors.Cause(err) == db.ErrNotExist {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	out := iotexapi.ReadStateResponse{
+		Data: data,
+		BlockIdentifier: &iotextypes.BlockIdentifier{
+			Height: readStateHeight,
+			Hash:   hex.EncodeToString(blkHash[:]),
+		},
+	}
+	return &out, nil
+}
+
+// SuggestGasPrice suggests gas price
+func (api *Server) SuggestGasPrice(ctx context.Context, in *iotexapi.SuggestGasPriceRequest) (*iotexapi.SuggestGasPriceResponse, error) {
+	suggestPrice, err := api.gs.SuggestGasPrice()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &iotexapi.SuggestGasPriceResponse{GasPrice: suggestPrice}, nil
+}
+
+// FeeHistory returns the gas used ratio and gas price percentiles of a range of blocks
+func (api *Server) FeeHistory(ctx context.Context, in *apipb.FeeHistoryRequest) (*apipb.FeeHistoryResponse, error) {
+	history, err := api.gs.FeeHistory(in.GetBlockCount(), in.GetNewestBlock(), in.GetRewardPercentiles())
+	if err != nil {
+		if errors.Cause(err) == gasstation.ErrInvalidFeeHistoryQuery {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res := &apipb.FeeHistoryResponse{
+		OldestBlock:  history.OldestBlock,
+		GasUsedRatio: history.GasUsedRatio,
+		Reward:       make([]*apipb.BlockFeeReward, 0, len(history.Reward)),
+	}
+	for _, prices := range history.Reward {
+		reward := &apipb.BlockFeeReward{GasPrices: make([]string, 0, len(prices))}
+		for _, price := range prices {
+			reward.GasPrices = append(reward.GasPrices, price.String())
+		}
+		res.Reward = append(res.Reward, reward)
+	}
+	return res, nil
+}
+
+// TraceTransaction traces the execution of a committed action by replaying it on the state at its parent height
+func (api *Server) TraceTransaction(ctx context.Context, in *apipb.TraceTransactionRequest) (*apipb.TraceResponse, error) {
+	if !api.hasActionIndex || api.indexer == nil {
+		return nil, status.Error(codes.NotFound, blockindex.ErrActionIndexNA.Error())
+	}
+	actHash, err := hash.HexStringToHash256(in.ActionHash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	selp, _, height, err := api.getActionByActionHash(actHash)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	exec, ok := selp.Action().(*action.Execution)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "only execution can be traced")
+	}
+	blk, err := api.dao.GetBlockByHeight(height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	actIndex := actionIndexInBlock(blk, actHash[:])
+	if actIndex < 0 {
+		return nil, status.Errorf(codes.Internal, "action %x is not in block %d", actHash, height)
+	}
+	producer, err := address.FromBytes(blk.PublicKey().Hash())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	ctx = protocol.WithBlockCtx(
+		protocol.WithBlockchainCtx(ctx, protocol.BlockchainCtx{
+			Genesis: api.cfg.Genesis,
+			Tip: protocol.TipInfo{
+				Height: height - 1,
+				Hash:   blk.PrevHash(),
+			},
+		}),
+		protocol.BlockCtx{
+			BlockHeight:    height,
+			BlockTimeStamp: blk.Timestamp(),
+			GasLimit:       api.cfg.Genesis.BlockGasLimit,
+			Producer:       producer,
+		},
+	)
+	ws, err := api.sf.WorkingSetAtHeight(ctx, height, blk.Actions[:actIndex]...)
+	if err != nil {
+		if errors.Cause(err) == factory.ErrNoArchiveData || errors.Cause(err) == factory.ErrNotSupported {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// the action is executed with the gas left in the block after the actions before it
+	receipts, err := api.dao.GetReceipts(height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if actIndex >= len(receipts) {
+		return nil, status.Errorf(codes.Internal, "receipt of action %x is not in block %d", actHash, height)
+	}
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	for _, r := range receipts[:actIndex] {
+		if blkCtx.GasLimit < r.GasConsumed {
+			blkCtx.GasLimit = 0
+			break
+		}
+		blkCtx.GasLimit -= r.GasConsumed
+	}
+	caller, err := address.FromBytes(selp.SrcPubkey().Hash())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	intrinsicGas, err := selp.IntrinsicGas()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	ctx = protocol.WithActionCtx(protocol.WithBlockCtx(ctx, blkCtx), protocol.ActionCtx{
+		Caller:       caller,
+		ActionHash:   actHash,
+		GasPrice:     selp.GasPrice(),
+		IntrinsicGas: intrinsicGas,
+		Nonce:        selp.Nonce(),
+	})
+	return api.trace(ctx, in.GetOptions(), func(ctx context.Context) ([]byte, *action.Receipt, error) {
+		return evm.ExecuteContract(
+			ctx,
+			ws,
+			exec,
+			api.dao.GetBlockHash,
+			func(context.Context, protocol.StateManager, *big.Int) (*action.TransactionLog, error) {
+				return nil, nil
+			},
+		)
+	})
+}
+
+// TraceCall traces the execution of a contract call on the tip state
+func (api *Server) TraceCall(ctx context.Context, in *apipb.TraceCallRequest) (*apipb.TraceResponse, error) {
+	sc := &action.Execution{}
+	if err := sc.LoadProto(in.Execution); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	state, err := accountutil.AccountState(api.sf, in.CallerAddress)
 	if err != nil {
-		return nil, status.Error(codes.NotFound, err.Error())
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	blkHash, err := api.dao.GetBlockHash(readStateHeight)
+	sc, _ = action.NewExecution(
+		sc.Contract(),
+		state.Nonce+1,
+		sc.Amount(),
+		api.cfg.Genesis.BlockGasLimit,
+		big.NewInt(0),
+		sc.Data(),
+	)
+	callerAddr, err := address.FromString(in.CallerAddress)
 	if err != nil {
-		if errors.Cause(err) == db.ErrNotExist {
-			return nil, status.Error(codes.NotFound, err.Error())
-		}
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-	out := iotexapi.ReadStateResponse{
-		Data: data,
-		BlockIdentifier: &iotextypes.BlockIdentifier{
-			Height: readStateHeight,
-			Hash:   hex.EncodeToString(blkHash[:]),
-		},
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	return &out, nil
-}
-
-// SuggestGasPrice suggests gas price
-func (api *Server) SuggestGasPrice(ctx context.Context, in *iotexapi.SuggestGasPriceRequest) (*iotexapi.SuggestGasPriceResponse, error) {
-	suggestPrice, err := api.gs.SuggestGasPrice()
+	ctx, err = api.bc.Context()
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
-	return &iotexapi.SuggestGasPriceResponse{GasPrice: suggestPrice}, nil
+	return api.trace(ctx, in.GetOptions(), func(ctx context.Context) ([]byte, *action.Receipt, error) {
+		return api.sf.SimulateExecution(ctx, callerAddr, sc, api.dao.GetBlockHash)
+	})
 }
 
 // EstimateGasForAction estimates gas for action
@@ -511,7 +1357,7 @@ func (api *Server) EstimateActionGasConsumption(ctx context.Context, in *iotexap
 	switch {
 	case in.GetExecution() != nil:
 		request := in.GetExecution()
-		return api.estimateActionGasConsumptionForExecution(request, in.GetCallerAddress())
+		return api.estimateActionGasConsumptionForExecution(request, in.GetCallerAddress(), nil)
 	case in.GetTransfer() != nil:
 		respone.Gas = uint64(len(in.GetTransfer().Payload))*action.TransferPayloadGas + action.TransferBaseIntrinsicGas
 	case in.GetStakeCreate() != nil:
@@ -749,6 +1595,83 @@ func (api *Server) StreamLogs(in *iotexapi.StreamLogsRequest, stream iotexapi.AP
 	}
 }
 
+// StreamPendingActions streams the events of pending actions in actpool
+func (api *Server) StreamPendingActions(in *apipb.StreamPendingActionsRequest, stream apipb.APIService_StreamPendingActionsServer) error {
+	for _, addr := range in.GetAddresses() {
+		if _, err := address.FromString(addr); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	errChan := make(chan error)
+	if err := api.actionListener.AddResponder(NewPendingActionListener(in.GetAddresses(), stream, errChan)); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	for {
+		select {
+		case err := <-errChan:
+			if err != nil {
+				err = status.Error(codes.Aborted, err.Error())
+			}
+			return err
+		}
+	}
+}
+
+// StreamBlocksFrom streams blocks, the blocks from the start height are replayed before new blocks
+func (api *Server) StreamBlocksFrom(in *apipb.StreamBlocksFromRequest, stream apipb.APIService_StreamBlocksFromServer) error {
+	errChan := make(chan error, 1)
+	backfill, err := api.addBlockResponder(NewBlockListener(stream, errChan), in.GetStartHeight(), api.allHeights)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if err := backfill(); err != nil {
+		return status.Error(codes.Aborted, err.Error())
+	}
+	if err := <-errChan; err != nil {
+		return status.Error(codes.Aborted, err.Error())
+	}
+	return nil
+}
+
+// StreamLogsFrom streams logs that match the filter condition, the logs from the start height are replayed before the
+// logs in new blocks
+func (api *Server) StreamLogsFrom(in *apipb.StreamLogsFromRequest, stream apipb.APIService_StreamLogsFromServer) error {
+	if in.GetFilter() == nil {
+		return status.Error(codes.InvalidArgument, "empty filter")
+	}
+	errChan := make(chan error, 1)
+	filter := logfilter.NewLogFilter(in.GetFilter(), stream, errChan)
+	backfill, err := api.addBlockResponder(filter, in.GetStartHeight(), api.logHeights(filter))
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if err := backfill(); err != nil {
+		return status.Error(codes.Aborted, err.Error())
+	}
+	if err := <-errChan; err != nil {
+		return status.Error(codes.Aborted, err.Error())
+	}
+	return nil
+}
+
+// StreamBlockEvents streams the events of created and reverted blocks, the blocks from the start height are replayed
+// before new blocks
+func (api *Server) StreamBlockEvents(in *apipb.StreamBlockEventsRequest, stream apipb.APIService_StreamBlockEventsServer) error {
+	errChan := make(chan error, 1)
+	backfill, err := api.addBlockResponder(NewBlockEventListener(stream, errChan), in.GetStartHeight(), api.allHeights)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if err := backfill(); err != nil {
+		return status.Error(codes.Aborted, err.Error())
+	}
+	if err := <-errChan; err != nil {
+		return status.Error(codes.Aborted, err.Error())
+	}
+	return nil
+}
+
 // GetElectionBuckets returns the native election buckets.
 func (api *Server) GetElectionBuckets(
 	ctx context.Context,
@@ -919,15 +1842,63 @@ func (api *Server) Start() error {
 	if err := api.chainListener.Start(); err != nil {
 		return errors.Wrap(err, "failed to start blockchain listener")
 	}
+	if err := api.bc.AddSubscriber(api.gs); err != nil {
+		return errors.Wrap(err, "failed to subscribe gas station to block creations")
+	}
+	if err := api.ap.AddActionEventSubscriber(api.actionListener); err != nil {
+		return errors.Wrap(err, "failed to subscribe to actpool events")
+	}
+	if err := api.actionListener.Start(); err != nil {
+		return errors.Wrap(err, "failed to start actpool listener")
+	}
+	if api.web3Server != nil {
+		if err := api.web3Server.Start(context.Background()); err != nil {
+			return err
+		}
+	}
+	if api.graphQLServer != nil {
+		if err := api.graphQLServer.Start(context.Background()); err != nil {
+			return err
+		}
+	}
+	if api.websocketServer != nil {
+		if err := api.websocketServer.Start(context.Background()); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 // Stop stops the API server
 func (api *Server) Stop() error {
 	api.grpcServer.Stop()
+	if api.web3Server != nil {
+		if err := api.web3Server.Stop(context.Background()); err != nil {
+			return errors.Wrap(err, "failed to stop web3 server")
+		}
+	}
+	if api.graphQLServer != nil {
+		if err := api.graphQLServer.Stop(context.Background()); err != nil {
+			return errors.Wrap(err, "failed to stop graphql server")
+		}
+	}
+	if api.websocketServer != nil {
+		if err := api.websocketServer.Stop(context.Background()); err != nil {
+			return errors.Wrap(err, "failed to stop websocket server")
+		}
+	}
 	if err := api.bc.RemoveSubscriber(api.chainListener); err != nil {
 		return errors.Wrap(err, "failed to unsubscribe blockchain listener")
 	}
+	if err := api.bc.RemoveSubscriber(api.gs); err != nil {
+		return errors.Wrap(err, "failed to unsubscribe gas station")
+	}
+	if err := api.ap.RemoveActionEventSubscriber(api.actionListener); err != nil {
+		return errors.Wrap(err, "failed to unsubscribe actpool listener")
+	}
+	if err := api.actionListener.Stop(); err != nil {
+		return err
+	}
 	return api.chainListener.Stop()
 }
 
@@ -955,6 +1926,19 @@ func (api *Server) readState(ctx context.Context, p protocol.Protocol, height st
 		if err != nil {
 			return nil, uint64(0), err
 		}
+		if _, ok := p.(*staking.Protocol); ok && api.cfg.Chain.EnableArchiveMode && inputHeight != tipHeight {
+			// the staking state at the exact height is available in archive mode
+			sr, err := api.historyStateReader(inputHeight)
+			if err != nil {
+				return nil, uint64(0), err
+			}
+			hu := config.NewHeightUpgrade(&api.cfg.Genesis)
+			view, _, err := staking.CreateBaseView(sr, hu.IsPost(config.Greenland, inputHeight))
+			if err != nil {
+				return nil, uint64(0), err
+			}
+			return p.ReadState(ctx, &historyViewReader{StateReader: sr, name: p.Name(), view: view}, methodName, arguments...)
+		}
 		inputEpochNum := rp.GetEpochNum(inputHeight)
 		if inputEpochNum < tipEpochNum {
 			// old data, wrap to history state reader
@@ -1173,6 +2157,88 @@ func (api *Server) getActionsByBlock(blkHash string, start uint64, count uint64)
 	}, nil
 }
 
+// rangeCost returns the number of blocks in the height range, where 0 or a height beyond the tip means the tip
+func (api *Server) rangeCost(from, to uint64) uint64 {
+	tipHeight := api.bc.TipHeight()
+	if to == 0 || to > tipHeight {
+		to = tipHeight
+	}
+	if from > to {
+		return 1
+	}
+	return to - from + 1
+}
+
+// addBlockResponder adds the responder to chain listener. If the start height is not zero, the returned function
+// replays the blocks at the heights from the start height to tip height, and the responder then continues with new
+// blocks without gap or duplicate
+func (api *Server) addBlockResponder(
+	r Responder,
+	start uint64,
+	heights func(start, end uint64) ([]uint64, error),
+) (func() error, error) {
+	if start == 0 {
+		return func() error { return nil }, api.chainListener.AddResponder(r)
+	}
+	br := newBackfillResponder(r, start)
+	if err := api.chainListener.AddResponder(br); err != nil {
+		return nil, err
+	}
+	return func() error {
+		return api.backfill(br, heights)
+	}, nil
+}
+
+func (api *Server) backfill(br *backfillResponder, heights func(start, end uint64) ([]uint64, error)) error {
+	tipHeight := api.bc.TipHeight()
+	pageSize := api.cfg.API.RangeQueryLimit
+	if pageSize == 0 {
+		pageSize = tipHeight
+	}
+	for start := br.start; start <= tipHeight; start += pageSize {
+		end := start + pageSize - 1
+		if end > tipHeight {
+			end = tipHeight
+		}
+		hs, err := heights(start, end)
+		if err != nil {
+			br.abort(err)
+			return err
+		}
+		for _, h := range hs {
+			blk, err := api.dao.GetBlockByHeight(h)
+			if err != nil {
+				br.abort(err)
+				return err
+			}
+			if blk.Receipts, err = api.dao.GetReceipts(h); err != nil {
+				br.abort(err)
+				return err
+			}
+			if err := br.replay(blk); err != nil {
+				return err
+			}
+		}
+	}
+	return br.finish(tipHeight)
+}
+
+// allHeights returns all heights in range [start, end]
+func (api *Server) allHeights(start, end uint64) ([]uint64, error) {
+	hs := make([]uint64, 0, end-start+1)
+	for h := start; h <= end; h++ {
+		hs = append(hs, h)
+	}
+	return hs, nil
+}
+
+// logHeights returns a function which returns the heights in range that may have logs matching the filter
+func (api *Server) logHeights(filter *logfilter.LogFilter) func(start, end uint64) ([]uint64, error) {
+	return func(start, end uint64) ([]uint64, error) {
+		return api.bfIndexer.FilterBlocksInRange(filter, start, end)
+	}
+}
+
 // getBlockMetas returns blockmetas response within the height range
 func (api *Server) getBlockMetas(start uint64, count uint64) (*iotexapi.GetBlockMetasResponse, error) {
 	if count == 0 {
@@ -1521,16 +2587,15 @@ func (api *Server) getLogsInRange(filter *logfilter.LogFilter, start, end, pagin
 }
 
 // TODO: Since GasConsumed on the receipt may not be enough for the gas limit, we use binary search for the gas estimate. Need a better way to address it later.
-func (api *Server) estimateActionGasConsumptionForExecution(exec *iotextypes.Execution, sender string) (*iotexapi.EstimateActionGasConsumptionResponse, error) {
+func (api *Server) estimateActionGasConsumptionForExecution(exec *iotextypes.Execution, sender string, override *evm.StateOverride) (*iotexapi.EstimateActionGasConsumptionResponse, error) {
 	sc := &action.Execution{}
 	if err := sc.LoadProto(exec); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	state, err := accountutil.AccountState(api.sf, sender)
+	nonce, err := simulationNonce(api.sf, sender, override)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	nonce := state.Nonce + 1
 
 	callerAddr, err := address.FromString(sender)
 	if err != nil {
@@ -1546,7 +2611,7 @@ func (api *Server) estimateActionGasConsumptionForExecution(exec *iotextypes.Exe
 		sc.Data(),
 	)
 
-	ctx, err := api.bc.Context()
+	ctx, err := api.simulationContext(override)
 	if err != nil {
 		return nil, err
 	}
@@ -1562,7 +2627,7 @@ func (api *Server) estimateActionGasConsumptionForExecution(exec *iotextypes.Exe
 		return nil, status.Error(codes.Internal, "execution simulation is failed")
 	}
 	estimatedGas := receipt.GasConsumed
-	enough, err := api.isGasLimitEnough(callerAddr, sc, nonce, estimatedGas)
+	enough, err := api.isGasLimitEnough(callerAddr, sc, nonce, estimatedGas, override)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -1571,7 +2636,7 @@ func (api *Server) estimateActionGasConsumptionForExecution(exec *iotextypes.Exe
 		estimatedGas = high
 		for low <= high {
 			mid := (low + high) / 2
-			enough, err = api.isGasLimitEnough(callerAddr, sc, nonce, mid)
+			enough, err = api.isGasLimitEnough(callerAddr, sc, nonce, mid, override)
 			if err != nil {
 				return nil, status.Error(codes.Internal, err.Error())
 			}
@@ -1600,6 +2665,7 @@ func (api *Server) isGasLimitEnough(
 	sc *action.Execution,
 	nonce uint64,
 	gasLimit uint64,
+	override *evm.StateOverride,
 ) (bool, error) {
 	sc, _ = action.NewExecution(
 		sc.Contract(),
@@ -1609,7 +2675,7 @@ func (api *Server) isGasLimitEnough(
 		big.NewInt(0),
 		sc.Data(),
 	)
-	ctx, err := api.bc.Context()
+	ctx, err := api.simulationContext(override)
 	if err != nil {
 		return false, err
 	}
@@ -1620,6 +2686,57 @@ func (api *Server) isGasLimitEnough(
 	return receipt.Status == uint64(iotextypes.ReceiptStatus_Success), nil
 }
 
+// trace runs the execution with the tracer specified in options
+func (api *Server) trace(
+	ctx context.Context,
+	opts *apipb.TraceOptions,
+	execute func(context.Context) ([]byte, *action.Receipt, error),
+) (*apipb.TraceResponse, error) {
+	var (
+		tracer       vm.Tracer
+		structLogger *vm.StructLogger
+		callTracer   *evm.CallTracer
+	)
+	switch opts.GetTracer() {
+	case apipb.TracerType_STRUCT_LOG:
+		structLogger = vm.NewStructLogger(&vm.LogConfig{
+			DisableMemory:  !opts.GetEnableMemory(),
+			DisableStack:   opts.GetDisableStack(),
+			DisableStorage: opts.GetDisableStorage(),
+			Limit:          int(opts.GetLimit()),
+		})
+		tracer = structLogger
+	case apipb.TracerType_CALL:
+		callTracer = evm.NewCallTracer()
+		tracer = callTracer
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown tracer %s", opts.GetTracer())
+	}
+	retval, receipt, err := execute(protocol.WithVMConfigCtx(ctx, vm.Config{
+		Debug:  true,
+		Tracer: tracer,
+	}))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res := &apipb.TraceResponse{
+		GasUsed:      receipt.GasConsumed,
+		Failed:       receipt.Status != uint64(iotextypes.ReceiptStatus_Success),
+		ReturnValue:  retval,
+		Receipt:      toReceiptPb(receipt),
+		RevertReason: receipt.RevertReason(),
+	}
+	if structLogger != nil {
+		res.StructLogs = toStructLogsPb(structLogger.StructLogs())
+	}
+	if callTracer != nil && callTracer.Result() != nil {
+		if res.Call, err = toCallFramePb(callTracer.Result()); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return res, nil
+}
+
 func (api *Server) getProductivityByEpoch(
 	rp *rolldpos.Protocol,
 	epochNum uint64,
@@ -1641,7 +2758,7 @@ func (api *Server) getProductivityByEpoch(
 	return num, produce, nil
 }
 
-func (api *Server) getProtocolAccount(ctx context.Context, addr string) (ret *iotexapi.GetAccountResponse, err error) {
+func (api *Server) getProtocolAccount(ctx context.Context, addr string, height uint64) (ret *iotexapi.GetAccountResponse, err error) {
 	var req *iotexapi.ReadStateRequest
 	var balance string
 	var out *iotexapi.ReadStateResponse
@@ -1651,6 +2768,9 @@ func (api *Server) getProtocolAccount(ctx context.Context, addr string) (ret *io
 			ProtocolID: []byte("rewarding"),
 			MethodName: []byte("TotalBalance"),
 		}
+		if height != 0 {
+			req.Height = strconv.FormatUint(height, 10)
+		}
 		out, err = api.ReadState(ctx, req)
 		if err != nil {
 			return
@@ -1681,6 +2801,9 @@ func (api *Server) getProtocolAccount(ctx context.Context, addr string) (ret *io
 			MethodName: methodName,
 			Arguments:  [][]byte{arg},
 		}
+		if height != 0 {
+			req.Height = strconv.FormatUint(height, 10)
+		}
 		out, err = api.ReadState(ctx, req)
 		if err != nil {
 			return nil, err
@@ -1729,3 +2852,260 @@ func (api *Server) GetActPoolActions(ctx context.Context, in *iotexapi.GetActPoo
 
 	return ret, nil
 }
+
+// simulationNonce returns the nonce of the simulated execution sent by the caller
+func simulationNonce(sr protocol.StateReader, caller string, override *evm.StateOverride) (uint64, error) {
+	if override != nil {
+		if account, ok := override.Accounts[caller]; ok && account != nil && account.Nonce != nil {
+			return *account.Nonce + 1, nil
+		}
+	}
+	state, err := accountutil.AccountState(sr, caller)
+	if err != nil {
+		return 0, err
+	}
+	return state.Nonce + 1, nil
+}
+
+// simulationContext returns the context of simulated execution on the tip, with the state override if it is not nil
+func (api *Server) simulationContext(override *evm.StateOverride) (context.Context, error) {
+	ctx, err := api.bc.Context()
+	if err != nil {
+		return nil, err
+	}
+	if override != nil {
+		ctx = evm.WithStateOverrideCtx(ctx, override)
+	}
+	return ctx, nil
+}
+
+// checkHistoryHeight checks whether the state at the height is available
+func (api *Server) checkHistoryHeight(height uint64) error {
+	if !api.cfg.Chain.EnableArchiveMode {
+		return status.Error(codes.FailedPrecondition, "archive mode is disabled, only the state at tip height is available")
+	}
+	tipHeight := api.bc.TipHeight()
+	if height == 0 || height > tipHeight {
+		return status.Errorf(codes.InvalidArgument, "height %d is out of range [1, %d]", height, tipHeight)
+	}
+	if retention := api.cfg.DB.HistoryStateRetention; retention != 0 && tipHeight-height > retention {
+		return status.Errorf(
+			codes.FailedPrecondition,
+			"state at height %d is out of history state retention %d, the earliest height available is %d",
+			height,
+			retention,
+			tipHeight-retention,
+		)
+	}
+	return nil
+}
+
+// historyStateReader returns the state reader at the height, which must be in the history state retention
+func (api *Server) historyStateReader(height uint64) (protocol.StateReader, error) {
+	if err := api.checkHistoryHeight(height); err != nil {
+		return nil, err
+	}
+	return factory.NewHistoryStateReader(api.sf, height), nil
+}
+
+// workingSetAtHeight returns the working set on the state at the height, and the context of simulated execution on it
+func (api *Server) workingSetAtHeight(height uint64) (context.Context, protocol.StateManager, error) {
+	if err := api.checkHistoryHeight(height); err != nil {
+		return nil, nil, err
+	}
+	header, err := api.bc.BlockHeaderByHeight(height)
+	if err != nil {
+		return nil, nil, status.Error(codes.NotFound, err.Error())
+	}
+	ctx := protocol.WithBlockchainCtx(
+		protocol.WithRegistry(context.Background(), api.registry),
+		protocol.BlockchainCtx{
+			Genesis: api.cfg.Genesis,
+			Tip: protocol.TipInfo{
+				Height:    height,
+				Hash:      header.HashBlock(),
+				Timestamp: header.Timestamp(),
+			},
+		},
+	)
+	ws, err := api.sf.WorkingSetAtHeight(
+		protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+			BlockHeight:    height + 1,
+			BlockTimeStamp: header.Timestamp().Add(api.cfg.Genesis.BlockInterval),
+			GasLimit:       api.cfg.Genesis.BlockGasLimit,
+		}),
+		height+1,
+	)
+	if err != nil {
+		if errors.Cause(err) == factory.ErrNoArchiveData || errors.Cause(err) == factory.ErrNotSupported {
+			return nil, nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+	return ctx, ws, nil
+}
+
+// stateOverrideFromPb converts the state override from protobuf
+func stateOverrideFromPb(pb *apipb.StateOverride) (*evm.StateOverride, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	override := &evm.StateOverride{
+		Accounts:    make(map[string]*evm.AccountOverride, len(pb.GetAccounts())),
+		BlockHeight: pb.GetBlockHeight(),
+	}
+	if pb.GetBlockTimestamp() != nil {
+		ts, err := ptypes.Timestamp(pb.GetBlockTimestamp())
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid block timestamp")
+		}
+		override.BlockTimeStamp = ts
+	}
+	for _, accountPb := range pb.GetAccounts() {
+		addr, err := address.FromString(accountPb.GetAddress())
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid address %s", accountPb.GetAddress())
+		}
+		if _, ok := override.Accounts[addr.String()]; ok {
+			return nil, errors.Errorf("duplicate override of address %s", addr.String())
+		}
+		account := &evm.AccountOverride{}
+		if accountPb.GetBalance() != "" {
+			balance, ok := new(big.Int).SetString(accountPb.GetBalance(), 10)
+			if !ok || balance.Sign() < 0 {
+				return nil, errors.Errorf("invalid balance %s", accountPb.GetBalance())
+			}
+			account.Balance = balance
+		}
+		if accountPb.GetOverrideNonce() {
+			nonce := accountPb.GetNonce()
+			account.Nonce = &nonce
+		}
+		if len(accountPb.GetCode()) > 0 {
+			account.Code = accountPb.GetCode()
+		}
+		if len(accountPb.GetStorage()) > 0 {
+			account.Storage = make(map[common.Hash]common.Hash, len(accountPb.GetStorage()))
+			for _, entry := range accountPb.GetStorage() {
+				k, err := decodeStorageSlot(entry.GetKey())
+				if err != nil {
+					return nil, errors.Wrap(err, "invalid storage key")
+				}
+				v, err := decodeStorageSlot(entry.GetValue())
+				if err != nil {
+					return nil, errors.Wrap(err, "invalid storage value")
+				}
+				account.Storage[k] = v
+			}
+		}
+		override.Accounts[addr.String()] = account
+	}
+	return override, nil
+}
+
+func hashesToBytes(hashes []hash.Hash256) [][]byte {
+	b := make([][]byte, 0, len(hashes))
+	for i := range hashes {
+		b = append(b, hashes[i][:])
+	}
+	return b
+}
+
+func stateProofToPb(proof *factory.StateProof) *apipb.StateProof {
+	return &apipb.StateProof{
+		Height:         proof.Height,
+		RootHash:       proof.RootHash,
+		NamespaceProof: proof.NamespaceProof,
+		KeyProof:       proof.KeyProof,
+	}
+}
+
+func decodeStorageSlot(s string) (common.Hash, error) {
+	b, err := hexutil.Decode(s)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if len(b) != common.HashLength {
+		return common.Hash{}, errors.Errorf("invalid length %d of storage slot %s", len(b), s)
+	}
+	return common.BytesToHash(b), nil
+}
+
+// toReceiptPb converts the receipt to protobuf, with the locally decoded revert reason if it is not in the receipt
+func toReceiptPb(receipt *action.Receipt) *iotextypes.Receipt {
+	r := receipt.ConvertToReceiptPb()
+	if r.ExecutionRevertMsg == "" {
+		r.ExecutionRevertMsg = receipt.RevertReason()
+	}
+	return r
+}
+
+func toStructLogsPb(logs []vm.StructLog) []*apipb.StructLog {
+	res := make([]*apipb.StructLog, 0, len(logs))
+	for _, l := range logs {
+		structLog := &apipb.StructLog{
+			Pc:      l.Pc,
+			Op:      l.Op.String(),
+			Gas:     l.Gas,
+			GasCost: l.GasCost,
+			Depth:   uint64(l.Depth),
+			Error:   l.ErrorString(),
+			Memory:  l.Memory,
+			Refund:  l.RefundCounter,
+		}
+		for _, item := range l.Stack {
+			structLog.Stack = append(structLog.Stack, hexutil.EncodeBig(item))
+		}
+		keys := make([]common.Hash, 0, len(l.Storage))
+		for k := range l.Storage {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return bytes.Compare(keys[i][:], keys[j][:]) < 0
+		})
+		for _, k := range keys {
+			structLog.Storage = append(structLog.Storage, &apipb.StorageEntry{
+				Key:   k.Hex(),
+				Value: l.Storage[k].Hex(),
+			})
+		}
+		res = append(res, structLog)
+	}
+	return res
+}
+
+func toCallFramePb(call *evm.CallFrame) (*apipb.CallFrame, error) {
+	from, err := address.FromBytes(call.From.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	res := &apipb.CallFrame{
+		Type:         call.Type,
+		From:         from.String(),
+		Gas:          call.Gas,
+		GasUsed:      call.GasUsed,
+		Input:        call.Input,
+		Output:       call.Output,
+		Error:        call.Error,
+		RevertReason: call.RevertReason,
+	}
+	// the callee is absent if the contract fails to be created
+	if call.To != (common.Address{}) {
+		to, err := address.FromBytes(call.To.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		res.To = to.String()
+	}
+	if call.Value != nil {
+		res.Value = call.Value.String()
+	}
+	for _, c := range call.Calls {
+		callPb, err := toCallFramePb(c)
+		if err != nil {
+			return nil, err
+		}
+		res.Calls = append(res.Calls, callPb)
+	}
+	return res, nil
+}
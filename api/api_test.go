@@ -12,6 +12,7 @@ import (
 	"math"
 	"math/big"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -37,6 +38,7 @@ import (
 	"github.com/iotexproject/iotex-core/action/protocol/rewarding"
 	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
 	"github.com/iotexproject/iotex-core/actpool"
+	"github.com/iotexproject/iotex-core/api/apipb"
 	"github.com/iotexproject/iotex-core/blockchain"
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/blockchain/blockdao"
@@ -50,7 +52,9 @@ import (
 	"github.com/iotexproject/iotex-core/state/factory"
 	"github.com/iotexproject/iotex-core/test/identityset"
 	"github.com/iotexproject/iotex-core/test/mock/mock_actpool"
+	"github.com/iotexproject/iotex-core/test/mock/mock_apiserver"
 	"github.com/iotexproject/iotex-core/test/mock/mock_blockchain"
+	"github.com/iotexproject/iotex-core/test/mock/mock_blockdao"
 	"github.com/iotexproject/iotex-core/testutil"
 )
 
@@ -790,13 +794,13 @@ func TestServer_GetAccount(t *testing.T) {
 	require.Error(err)
 
 	// success: reward pool
-	res, err := svr.getProtocolAccount(context.Background(), address.RewardingPoolAddr)
+	res, err := svr.getProtocolAccount(context.Background(), address.RewardingPoolAddr, 0)
 	require.NoError(err)
 	require.Equal(address.RewardingPoolAddr, res.AccountMeta.Address)
 	require.Equal("200000000000000000000000000", res.AccountMeta.Balance)
 
 	//failure: protocol staking isn't registered
-	_, err = svr.getProtocolAccount(context.Background(), address.StakingBucketPoolAddr)
+	_, err = svr.getProtocolAccount(context.Background(), address.StakingBucketPoolAddr, 0)
 	require.Contains(err.Error(), "protocol staking isn't registered")
 }
 
@@ -1198,6 +1202,90 @@ func TestServer_StreamLogs(t *testing.T) {
 	require.Error(err)
 }
 
+func TestServer_StreamBlocksFrom(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	require := require.New(t)
+	cfg := newConfig(t)
+	cfg.API.RangeQueryLimit = 2
+
+	svr, bfIndexFile, err := createServer(cfg, false)
+	require.NoError(err)
+	defer func() {
+		testutil.CleanupPath(t, bfIndexFile)
+	}()
+	svr.chainListener = NewChainListener()
+
+	tipHeight := svr.bc.TipHeight()
+	heights := make(chan uint64, tipHeight+1)
+	stream := mock_apiserver.NewMockStreamBlocksServer(ctrl)
+	stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(res *iotexapi.StreamBlocksResponse) error {
+		heights <- res.GetBlock().GetBlock().GetHeader().GetCore().GetHeight()
+		return nil
+	}).AnyTimes()
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- svr.StreamBlocksFrom(&apipb.StreamBlocksFromRequest{StartHeight: 2}, stream)
+	}()
+	// the historical blocks are replayed in order
+	for h := uint64(2); h <= tipHeight; h++ {
+		require.Equal(h, <-heights)
+	}
+	// the tip block is not sent again, and new blocks follow
+	tip, err := svr.dao.GetBlockByHeight(tipHeight)
+	require.NoError(err)
+	require.NoError(svr.chainListener.ReceiveBlock(tip))
+	next, err := block.NewTestingBuilder().SetHeight(tipHeight + 1).SignAndBuild(identityset.PrivateKey(27))
+	require.NoError(err)
+	require.NoError(svr.chainListener.ReceiveBlock(&next))
+	require.Equal(tipHeight+1, <-heights)
+	require.NoError(svr.chainListener.Stop())
+	require.NoError(<-errChan)
+	require.Empty(heights)
+}
+
+func TestServer_StreamBlockEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	require := require.New(t)
+	cfg := newConfig(t)
+
+	svr, bfIndexFile, err := createServer(cfg, false)
+	require.NoError(err)
+	defer func() {
+		testutil.CleanupPath(t, bfIndexFile)
+	}()
+	svr.chainListener = NewChainListener()
+
+	type event struct {
+		typ    apipb.BlockEventType
+		height uint64
+	}
+	events := make(chan event, 10)
+	stream := mock_apiserver.NewMockStreamBlockEventsServer(ctrl)
+	stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(res *apipb.StreamBlockEventsResponse) error {
+		events <- event{res.GetType(), res.GetBlock().GetBlock().GetHeader().GetCore().GetHeight()}
+		return nil
+	}).AnyTimes()
+	errChan := make(chan error, 1)
+	tipHeight := svr.bc.TipHeight()
+	go func() {
+		errChan <- svr.StreamBlockEvents(&apipb.StreamBlockEventsRequest{StartHeight: tipHeight}, stream)
+	}()
+	require.Equal(event{apipb.BlockEventType_CREATED, tipHeight}, <-events)
+
+	// the tip block is reverted, and replaced by a new block
+	tip, err := svr.dao.GetBlockByHeight(tipHeight)
+	require.NoError(err)
+	require.NoError(svr.chainListener.RevertBlock(tip))
+	require.Equal(event{apipb.BlockEventType_REVERTED, tipHeight}, <-events)
+	require.NoError(svr.chainListener.ReceiveBlock(tip))
+	require.Equal(event{apipb.BlockEventType_CREATED, tipHeight}, <-events)
+	require.NoError(svr.chainListener.Stop())
+	require.NoError(<-errChan)
+	require.Empty(events)
+}
+
 func TestServer_GetReceiptByAction(t *testing.T) {
 	require := require.New(t)
 	cfg := newConfig(t)
@@ -1219,6 +1307,398 @@ func TestServer_GetReceiptByAction(t *testing.T) {
 	}
 }
 
+func TestServer_GetActionProof(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig(t)
+
+	svr, bfIndexFile, err := createServer(cfg, false)
+	require.NoError(err)
+	defer func() {
+		testutil.CleanupPath(t, bfIndexFile)
+	}()
+
+	toHashes := func(b [][]byte) []hash.Hash256 {
+		hashes := make([]hash.Hash256, 0, len(b))
+		for _, h := range b {
+			hashes = append(hashes, hash.BytesToHash256(h))
+		}
+		return hashes
+	}
+	for _, test := range getReceiptByActionTests {
+		res, err := svr.GetActionProof(context.Background(), &apipb.GetActionProofRequest{ActionHash: test.in})
+		require.NoError(err)
+		header := &block.Header{}
+		require.NoError(header.LoadFromBlockHeaderProto(res.BlockHeader))
+		require.Equal(test.blkHeight, header.Height())
+		require.NotNil(res.BlockFooter)
+
+		selp := action.SealedEnvelope{}
+		require.NoError(selp.LoadProto(res.Action))
+		actHash := selp.Hash()
+		require.Equal(test.in, hex.EncodeToString(actHash[:]))
+		require.True(header.VerifyActionProof(actHash, int(res.ActionIndex), toHashes(res.ActionProof)))
+		receipt := &action.Receipt{}
+		receipt.ConvertFromReceiptPb(res.Receipt)
+		require.Equal(test.status, receipt.Status)
+		require.True(header.VerifyReceiptProof(receipt.Hash(), int(res.ReceiptIndex), toHashes(res.ReceiptProof)))
+		require.False(header.VerifyReceiptProof(actHash, int(res.ReceiptIndex), toHashes(res.ReceiptProof)))
+	}
+
+	_, err = svr.GetActionProof(context.Background(), &apipb.GetActionProofRequest{ActionHash: "0x01"})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = svr.GetActionProof(context.Background(), &apipb.GetActionProofRequest{ActionHash: hex.EncodeToString(hash.ZeroHash256[:])})
+	require.Equal(codes.NotFound, status.Code(err))
+}
+
+func TestServer_GetTransfersByAddress(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig(t)
+
+	svr, bfIndexFile, err := createServer(cfg, false)
+	require.NoError(err)
+	defer func() {
+		testutil.CleanupPath(t, bfIndexFile)
+	}()
+
+	sender, recipient := identityset.Address(28).String(), identityset.Address(29).String()
+	request := &apipb.GetTransfersByAddressRequest{Address: recipient, Start: 0, Count: 10}
+	_, err = svr.GetTransfersByAddress(context.Background(), request)
+	require.Equal(codes.Unavailable, status.Code(err))
+
+	ctx := context.Background()
+	indexer, err := blockindex.NewTransferIndexer(db.NewMemKVStore())
+	require.NoError(err)
+	require.NoError(indexer.Start(ctx))
+	defer func() {
+		require.NoError(indexer.Stop(ctx))
+	}()
+	blk, err := block.NewTestingBuilder().
+		SetHeight(1).
+		SetTimeStamp(testutil.TimestampNow()).
+		SignAndBuild(identityset.PrivateKey(27))
+	require.NoError(err)
+	actHash := hash.Hash256b([]byte("transfer"))
+	blk.Receipts = []*action.Receipt{
+		(&action.Receipt{ActionHash: actHash}).AddTransactionLogs(
+			&action.TransactionLog{
+				Type:      iotextypes.TransactionLogType_GAS_FEE,
+				Amount:    big.NewInt(1),
+				Sender:    sender,
+				Recipient: identityset.Address(0).String(),
+			},
+			&action.TransactionLog{
+				Type:      iotextypes.TransactionLogType_IN_CONTRACT_TRANSFER,
+				Amount:    big.NewInt(2),
+				Sender:    sender,
+				Recipient: recipient,
+			},
+		),
+	}
+	require.NoError(indexer.PutBlock(ctx, &blk))
+	svr.transferIndexer = indexer
+
+	res, err := svr.GetTransfersByAddress(context.Background(), request)
+	require.NoError(err)
+	require.EqualValues(1, res.Total)
+	require.Len(res.Transfers, 1)
+	transfer := res.Transfers[0]
+	require.EqualValues(1, transfer.BlkHeight)
+	require.Equal(hex.EncodeToString(actHash[:]), transfer.ActionHash)
+	require.Equal(iotextypes.TransactionLogType_IN_CONTRACT_TRANSFER, transfer.Type)
+	require.Equal("2", transfer.Amount)
+	require.Equal(sender, transfer.Sender)
+	require.Equal(recipient, transfer.Recipient)
+
+	res, err = svr.GetTransfersByAddress(context.Background(), &apipb.GetTransfersByAddressRequest{Address: sender, Start: 1, Count: 10})
+	require.NoError(err)
+	require.EqualValues(2, res.Total)
+	require.Len(res.Transfers, 1)
+	require.Equal("2", res.Transfers[0].Amount)
+	// start beyond the total returns no transfers
+	res, err = svr.GetTransfersByAddress(context.Background(), &apipb.GetTransfersByAddressRequest{Address: sender, Start: 2, Count: 10})
+	require.NoError(err)
+	require.EqualValues(2, res.Total)
+	require.Empty(res.Transfers)
+	res, err = svr.GetTransfersByAddress(context.Background(), &apipb.GetTransfersByAddressRequest{Address: identityset.Address(30).String(), Start: 0, Count: 10})
+	require.NoError(err)
+	require.Zero(res.Total)
+
+	_, err = svr.GetTransfersByAddress(context.Background(), &apipb.GetTransfersByAddressRequest{Address: sender, Count: 0})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = svr.GetTransfersByAddress(context.Background(), &apipb.GetTransfersByAddressRequest{Address: sender, Count: cfg.API.RangeQueryLimit + 1})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = svr.GetTransfersByAddress(context.Background(), &apipb.GetTransfersByAddressRequest{Address: "invalid", Count: 1})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_GetActionsByCursor(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig(t)
+	cfg.API.RangeQueryLimit = 10
+
+	svr, bfIndexFile, err := createServer(cfg, false)
+	require.NoError(err)
+	defer func() {
+		testutil.CleanupPath(t, bfIndexFile)
+	}()
+	ctx := context.Background()
+
+	hashes := func(infos []*iotexapi.ActionInfo) []string {
+		res := make([]string, 0, len(infos))
+		for _, info := range infos {
+			res = append(res, info.ActHash)
+		}
+		return res
+	}
+	// pages through the actions until the cursor is exhausted
+	pages := func(req *apipb.GetActionsByCursorRequest) []*iotexapi.ActionInfo {
+		var infos []*iotexapi.ActionInfo
+		for {
+			res, err := svr.GetActionsByCursor(ctx, req)
+			require.NoError(err)
+			infos = append(infos, res.ActionInfo...)
+			if res.NextCursor == "" {
+				return infos
+			}
+			req.Cursor = res.NextCursor
+		}
+	}
+	reverse := func(s []string) []string {
+		res := make([]string, len(s))
+		for i := range s {
+			res[len(s)-1-i] = s[i]
+		}
+		return res
+	}
+
+	// all actions
+	total, err := svr.indexer.GetTotalActions()
+	require.NoError(err)
+	require.True(total > cfg.API.RangeQueryLimit)
+	allHashes, err := svr.indexer.GetActionHashFromIndex(0, total)
+	require.NoError(err)
+	var all []string
+	for _, h := range allHashes {
+		all = append(all, hex.EncodeToString(h))
+	}
+	infos := pages(&apipb.GetActionsByCursorRequest{Count: 3})
+	require.Equal(all, hashes(infos))
+	infos = pages(&apipb.GetActionsByCursorRequest{Count: 4, NewestFirst: true})
+	require.Equal(reverse(all), hashes(infos))
+
+	// the cursor stays valid as new actions arrive
+	addr := identityset.Address(30).String()
+	byAddr, err := svr.getActionsByAddress(addr, 0, 10)
+	require.NoError(err)
+	res, err := svr.GetActionsByCursor(ctx, &apipb.GetActionsByCursorRequest{Address: addr, Count: 2})
+	require.NoError(err)
+	require.Equal(hashes(byAddr.ActionInfo[:2]), hashes(res.ActionInfo))
+	cursor := res.NextCursor
+	require.NotEmpty(cursor)
+	res, err = svr.GetActionsByCursor(ctx, &apipb.GetActionsByCursorRequest{Address: addr, Count: 2, Cursor: cursor})
+	require.NoError(err)
+	require.Equal(hashes(byAddr.ActionInfo[2:4]), hashes(res.ActionInfo))
+
+	// filters
+	for _, c := range []struct {
+		filter *apipb.ActionFilter
+		match  func(*iotexapi.ActionInfo) bool
+	}{
+		{
+			&apipb.ActionFilter{Types: []string{"execution"}},
+			func(info *iotexapi.ActionInfo) bool { return info.Action.Core.GetExecution() != nil },
+		},
+		{
+			&apipb.ActionFilter{Counterparty: identityset.Address(31).String()},
+			func(info *iotexapi.ActionInfo) bool {
+				return info.Action.Core.GetTransfer().GetRecipient() == identityset.Address(31).String() ||
+					info.Action.Core.GetExecution().GetContract() == identityset.Address(31).String()
+			},
+		},
+		{
+			&apipb.ActionFilter{FromHeight: 2, ToHeight: 3},
+			func(info *iotexapi.ActionInfo) bool { return info.BlkHeight >= 2 && info.BlkHeight <= 3 },
+		},
+		{
+			&apipb.ActionFilter{FromHeight: 4, Types: []string{"transfer"}},
+			func(info *iotexapi.ActionInfo) bool {
+				return info.BlkHeight == 4 && info.Action.Core.GetTransfer() != nil
+			},
+		},
+	} {
+		var expected []string
+		for _, info := range byAddr.ActionInfo {
+			if c.match(info) {
+				expected = append(expected, info.ActHash)
+			}
+		}
+		require.NotEmpty(expected)
+		infos := pages(&apipb.GetActionsByCursorRequest{Address: addr, Count: 1, Filter: c.filter})
+		require.Equal(expected, hashes(infos))
+		infos = pages(&apipb.GetActionsByCursorRequest{Address: addr, Count: 2, NewestFirst: true, Filter: c.filter})
+		require.Equal(reverse(expected), hashes(infos))
+	}
+
+	// invalid requests
+	for _, req := range []*apipb.GetActionsByCursorRequest{
+		{Count: 0},
+		{Count: 11},
+		{Address: "invalid", Count: 1},
+		{Count: 1, Cursor: "invalid"},
+		{Address: addr, Count: 2, Cursor: cursor, NewestFirst: true},
+	} {
+		_, err := svr.GetActionsByCursor(ctx, req)
+		require.Equal(codes.InvalidArgument, status.Code(err))
+	}
+
+	// the action in index which fails to be read is not skipped silently
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	dao := mock_blockdao.NewMockBlockDAO(ctrl)
+	dao.EXPECT().GetBlockByHeight(gomock.Any()).Return(nil, db.ErrNotExist).AnyTimes()
+	svr.dao = dao
+	_, err = svr.GetActionsByCursor(ctx, &apipb.GetActionsByCursorRequest{Address: addr, Count: 2})
+	require.Equal(codes.Internal, status.Code(err))
+}
+
+func TestServer_GetPendingAccount(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig(t)
+
+	svr, bfIndexFile, err := createServer(cfg, true)
+	require.NoError(err)
+	defer func() {
+		testutil.CleanupPath(t, bfIndexFile)
+	}()
+	ctx := context.Background()
+
+	addr := identityset.Address(27).String()
+	queued, err := testutil.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), 8,
+		big.NewInt(1), []byte{}, testutil.TestGasLimit, big.NewInt(testutil.TestGasPriceInt64))
+	require.NoError(err)
+	require.NoError(svr.ap.Add(protocol.WithRegistry(ctx, svr.registry), queued))
+
+	res, err := svr.GetPendingAccount(ctx, &apipb.GetPendingAccountRequest{Address: addr})
+	require.NoError(err)
+	account, err := svr.GetAccount(ctx, &iotexapi.GetAccountRequest{Address: addr})
+	require.NoError(err)
+	require.Equal(addr, res.Address)
+	require.Equal(account.AccountMeta.Nonce, res.ConfirmedNonce)
+	require.Equal(account.AccountMeta.Balance, res.ConfirmedBalance)
+	require.Equal(account.AccountMeta.PendingNonce, res.PendingNonce)
+	require.EqualValues(6, res.PendingNonce)
+	pendingBalance, ok := new(big.Int).SetString(res.PendingBalance, 10)
+	require.True(ok)
+	confirmedBalance, _ := new(big.Int).SetString(res.ConfirmedBalance, 10)
+	require.True(pendingBalance.Cmp(confirmedBalance) < 0)
+	require.Len(res.ExecutableActions, 4)
+	for i, act := range res.ExecutableActions {
+		require.EqualValues(i+2, act.Action.Core.Nonce)
+		require.Equal(addr, act.Sender)
+	}
+	require.Len(res.QueuedActions, 1)
+	h := queued.Hash()
+	require.Equal(hex.EncodeToString(h[:]), res.QueuedActions[0].ActHash)
+	require.Equal([]uint64{6, 7}, res.NonceGaps)
+
+	_, err = svr.GetPendingAccount(ctx, &apipb.GetPendingAccountRequest{Address: "invalid"})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_GetTokenTransfersAndHolders(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig(t)
+
+	svr, bfIndexFile, err := createServer(cfg, false)
+	require.NoError(err)
+	defer func() {
+		testutil.CleanupPath(t, bfIndexFile)
+	}()
+
+	token, sender, recipient := identityset.Address(31), identityset.Address(28), identityset.Address(29)
+	_, err = svr.GetTokenTransfersByAddress(context.Background(), &apipb.GetTokenTransfersRequest{Address: sender.String(), Count: 1})
+	require.Equal(codes.Unavailable, status.Code(err))
+	_, err = svr.GetTokenHolders(context.Background(), &apipb.GetTokenHoldersRequest{Token: token.String(), Count: 1})
+	require.Equal(codes.Unavailable, status.Code(err))
+
+	ctx := context.Background()
+	indexer, err := blockindex.NewTokenIndexer(db.NewMemKVStore())
+	require.NoError(err)
+	require.NoError(indexer.Start(ctx))
+	defer func() {
+		require.NoError(indexer.Stop(ctx))
+	}()
+	topic := func(addr address.Address) hash.Hash256 {
+		return hash.BytesToHash256(addr.Bytes())
+	}
+	transferLog := func(from, to address.Address, amount int64) *action.Log {
+		data := hash.BytesToHash256(big.NewInt(amount).Bytes())
+		return &action.Log{
+			Address: token.String(),
+			Topics:  action.Topics{hash.Hash256b([]byte("Transfer(address,address,uint256)")), topic(from), topic(to)},
+			Data:    data[:],
+		}
+	}
+	blk, err := block.NewTestingBuilder().
+		SetHeight(1).
+		SetTimeStamp(testutil.TimestampNow()).
+		SignAndBuild(identityset.PrivateKey(27))
+	require.NoError(err)
+	actHash := hash.Hash256b([]byte("token transfer"))
+	blk.Receipts = []*action.Receipt{
+		(&action.Receipt{Status: uint64(iotextypes.ReceiptStatus_Success), ActionHash: actHash}).AddLogs(
+			transferLog(sender, recipient, 10),
+			transferLog(recipient, identityset.Address(30), 3),
+		),
+	}
+	// the sender has not received the token, so its balance is zero
+	require.NoError(indexer.PutBlock(ctx, &blk))
+	svr.tokenIndexer = indexer
+
+	res, err := svr.GetTokenTransfersByAddress(context.Background(), &apipb.GetTokenTransfersRequest{Address: recipient.String(), Start: 1, Count: 10})
+	require.NoError(err)
+	require.EqualValues(2, res.Total)
+	require.Len(res.Transfers, 1)
+	require.EqualValues(1, res.Transfers[0].BlkHeight)
+	require.Equal(hex.EncodeToString(actHash[:]), res.Transfers[0].ActionHash)
+	require.Equal(token.String(), res.Transfers[0].Token)
+	require.Equal(recipient.String(), res.Transfers[0].Sender)
+	require.Equal(identityset.Address(30).String(), res.Transfers[0].Recipient)
+	require.Equal("3", res.Transfers[0].Amount)
+	require.False(res.Transfers[0].Xrc721)
+
+	res, err = svr.GetTokenTransfersByToken(context.Background(), &apipb.GetTokenTransfersRequest{Address: token.String(), Count: 10})
+	require.NoError(err)
+	require.EqualValues(2, res.Total)
+	require.Len(res.Transfers, 2)
+	res, err = svr.GetTokenTransfersByAddress(context.Background(), &apipb.GetTokenTransfersRequest{Address: identityset.Address(32).String(), Count: 10})
+	require.NoError(err)
+	require.Zero(res.Total)
+	require.Empty(res.Transfers)
+
+	holders, err := svr.GetTokenHolders(context.Background(), &apipb.GetTokenHoldersRequest{Token: token.String(), Count: 10})
+	require.NoError(err)
+	require.EqualValues(3, holders.Total)
+	for i, h := range []struct {
+		addr    string
+		balance string
+	}{
+		{sender.String(), "0"},
+		{recipient.String(), "7"},
+		{identityset.Address(30).String(), "3"},
+	} {
+		require.Equal(h.addr, holders.Holders[i].Address)
+		require.Equal(h.balance, holders.Holders[i].Balance)
+	}
+
+	_, err = svr.GetTokenTransfersByToken(context.Background(), &apipb.GetTokenTransfersRequest{Address: token.String(), Count: 0})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = svr.GetTokenHolders(context.Background(), &apipb.GetTokenHoldersRequest{Token: token.String(), Count: cfg.API.RangeQueryLimit + 1})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = svr.GetTokenHolders(context.Background(), &apipb.GetTokenHoldersRequest{Token: "invalid", Count: 1})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
+
 func TestServer_ReadContract(t *testing.T) {
 	require := require.New(t)
 	cfg := newConfig(t)
@@ -1247,6 +1727,349 @@ func TestServer_ReadContract(t *testing.T) {
 	}
 }
 
+func TestServer_TraceTransaction(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig(t)
+
+	svr, bfIndexFile, err := createServer(cfg, false)
+	require.NoError(err)
+	request := &apipb.TraceTransactionRequest{
+		ActionHash: hex.EncodeToString(executionHash2[:]),
+	}
+	_, err = svr.TraceTransaction(context.Background(), request)
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+	testutil.CleanupPath(t, bfIndexFile)
+
+	cfg.Chain.EnableArchiveMode = true
+	svr, bfIndexFile, err = createServer(cfg, false)
+	require.NoError(err)
+	defer func() {
+		testutil.CleanupPath(t, bfIndexFile)
+	}()
+	receipt, err := svr.GetReceiptByActionHash(executionHash2)
+	require.NoError(err)
+
+	res, err := svr.TraceTransaction(context.Background(), request)
+	require.NoError(err)
+	require.Equal(receipt.GasConsumed, res.GasUsed)
+	require.Equal(receipt.Status != uint64(iotextypes.ReceiptStatus_Success), res.Failed)
+	require.Equal(receipt.Status, res.Receipt.Status)
+	require.Nil(res.Call)
+
+	request.Options = &apipb.TraceOptions{Tracer: apipb.TracerType_CALL}
+	res, err = svr.TraceTransaction(context.Background(), request)
+	require.NoError(err)
+	require.Equal(receipt.GasConsumed, res.GasUsed)
+	require.Empty(res.StructLogs)
+	require.NotNil(res.Call)
+	require.Equal("CALL", res.Call.Type)
+	require.Equal(identityset.Address(30).String(), res.Call.From)
+	require.Equal(identityset.Address(31).String(), res.Call.To)
+	require.Equal("1", res.Call.Value)
+	require.Equal([]byte{1}, res.Call.Input)
+
+	// the execution is replayed with the gas left in the block at its position
+	selp, _, height, err := svr.getActionByActionHash(executionHash2)
+	require.NoError(err)
+	blk, err := svr.dao.GetBlockByHeight(height)
+	require.NoError(err)
+	receipts, err := svr.dao.GetReceipts(height)
+	require.NoError(err)
+	gasUsed := selp.GasLimit()
+	for i, r := range receipts {
+		if r.ActionHash == executionHash2 {
+			require.Equal(blk.Actions[i].Hash(), executionHash2)
+			break
+		}
+		gasUsed += r.GasConsumed
+	}
+	require.True(gasUsed > selp.GasLimit())
+	cfg.Genesis.BlockGasLimit = svr.cfg.Genesis.BlockGasLimit
+	svr.cfg.Genesis.BlockGasLimit = gasUsed
+	_, err = svr.TraceTransaction(context.Background(), request)
+	require.NoError(err)
+	svr.cfg.Genesis.BlockGasLimit = gasUsed - 1
+	_, err = svr.TraceTransaction(context.Background(), request)
+	require.Error(err)
+	svr.cfg.Genesis.BlockGasLimit = cfg.Genesis.BlockGasLimit
+
+	// only execution can be traced
+	request.ActionHash = hex.EncodeToString(transferHash1[:])
+	_, err = svr.TraceTransaction(context.Background(), request)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	request.ActionHash = hex.EncodeToString(hash.ZeroHash256[:])
+	_, err = svr.TraceTransaction(context.Background(), request)
+	require.Equal(codes.NotFound, status.Code(err))
+}
+
+func TestServer_TraceCall(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig(t)
+
+	svr, bfIndexFile, err := createServer(cfg, false)
+	require.NoError(err)
+	defer func() {
+		testutil.CleanupPath(t, bfIndexFile)
+	}()
+
+	request := &apipb.TraceCallRequest{
+		Execution:     testExecution2.Proto().GetCore().GetExecution(),
+		CallerAddress: identityset.Address(30).String(),
+		Options:       &apipb.TraceOptions{Tracer: apipb.TracerType_CALL},
+	}
+	res, err := svr.TraceCall(context.Background(), request)
+	require.NoError(err)
+	require.False(res.Failed)
+	require.Equal("CALL", res.Call.Type)
+	require.Equal(identityset.Address(31).String(), res.Call.To)
+
+	request.Options.Tracer = apipb.TracerType(100)
+	_, err = svr.TraceCall(context.Background(), request)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_ReadContractWithOverride(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig(t)
+
+	svr, bfIndexFile, err := createServer(cfg, false)
+	require.NoError(err)
+	defer func() {
+		testutil.CleanupPath(t, bfIndexFile)
+	}()
+
+	// the contract returns the value of slot 0
+	contract := identityset.Address(29).String()
+	code := []byte{0x60, 0x00, 0x54, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+	slot := "0x" + strings.Repeat("00", 32)
+	value := "0x" + strings.Repeat("00", 31) + "2a"
+	request := &apipb.CallWithOverrideRequest{
+		Execution: &iotextypes.Execution{
+			Amount:   "0",
+			Contract: contract,
+		},
+		CallerAddress: identityset.Address(30).String(),
+		Override: &apipb.StateOverride{
+			Accounts: []*apipb.AccountOverride{
+				{
+					Address: contract,
+					Code:    code,
+					Storage: []*apipb.StorageEntry{{Key: slot, Value: value}},
+				},
+			},
+			BlockHeight: 1000,
+		},
+	}
+	res, err := svr.ReadContractWithOverride(context.Background(), request)
+	require.NoError(err)
+	require.Equal(strings.Repeat("00", 31)+"2a", res.Data)
+
+	// the override is not committed
+	readRes, err := svr.ReadContract(context.Background(), &iotexapi.ReadContractRequest{
+		Execution:     request.Execution,
+		CallerAddress: request.CallerAddress,
+	})
+	require.NoError(err)
+	require.Empty(readRes.Data)
+
+	gasRes, err := svr.EstimateExecutionGasWithOverride(context.Background(), request)
+	require.NoError(err)
+	require.NotZero(gasRes.Gas)
+
+	// the contract returns the balance of the caller
+	request.Override.Accounts = []*apipb.AccountOverride{
+		{
+			Address: contract,
+			Code:    []byte{0x33, 0x31, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3},
+		},
+		{
+			Address: request.CallerAddress,
+			Balance: "42",
+		},
+	}
+	res, err = svr.ReadContractWithOverride(context.Background(), request)
+	require.NoError(err)
+	require.Equal(strings.Repeat("00", 31)+"2a", res.Data)
+
+	// the contract reverts with Error("oops"), the response is returned with the revert reason
+	revertData, err := hex.DecodeString("08c379a0" + strings.Repeat("00", 31) + "20" + strings.Repeat("00", 31) + "04" + hex.EncodeToString([]byte("oops")) + strings.Repeat("00", 28))
+	require.NoError(err)
+	request.Override.Accounts = []*apipb.AccountOverride{
+		{
+			Address: contract,
+			// copy the revert data after the code into memory and revert with it
+			Code: append([]byte{0x60, 0x64, 0x60, 0x0c, 0x60, 0x00, 0x39, 0x60, 0x64, 0x60, 0x00, 0xfd}, revertData...),
+		},
+	}
+	res, err = svr.ReadContractWithOverride(context.Background(), request)
+	require.NoError(err)
+	require.Equal(hex.EncodeToString(revertData), res.Data)
+	require.NotEqual(uint64(iotextypes.ReceiptStatus_Success), res.Receipt.Status)
+	require.Equal("oops", res.Receipt.ExecutionRevertMsg)
+
+	// invalid override
+	for _, account := range []*apipb.AccountOverride{
+		{Address: "io1abc"},
+		{Address: contract, Balance: "-1"},
+		{Address: contract, Storage: []*apipb.StorageEntry{{Key: "0x01", Value: value}}},
+	} {
+		request.Override.Accounts = []*apipb.AccountOverride{account}
+		_, err = svr.ReadContractWithOverride(context.Background(), request)
+		require.Equal(codes.InvalidArgument, status.Code(err))
+	}
+}
+
+func TestServer_StateAtHeight(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig(t)
+
+	svr, bfIndexFile, err := createServer(cfg, false)
+	require.NoError(err)
+	addr := identityset.Address(30).String()
+	readRequest := &apipb.ReadContractAtHeightRequest{
+		Execution:     testExecution2.Proto().GetCore().GetExecution(),
+		CallerAddress: addr,
+		Height:        1,
+	}
+	// archive mode is required for history state
+	_, err = svr.GetAccountAtHeight(context.Background(), &apipb.GetAccountAtHeightRequest{Address: addr, Height: 1})
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+	_, err = svr.ReadContractAtHeight(context.Background(), readRequest)
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+	res, err := svr.GetAccountAtHeight(context.Background(), &apipb.GetAccountAtHeightRequest{Address: addr})
+	require.NoError(err)
+	require.Equal(svr.bc.TipHeight(), res.BlockIdentifier.Height)
+	testutil.CleanupPath(t, bfIndexFile)
+
+	cfg.Chain.EnableArchiveMode = true
+	svr, bfIndexFile, err = createServer(cfg, false)
+	require.NoError(err)
+	defer func() {
+		testutil.CleanupPath(t, bfIndexFile)
+	}()
+	tipHeight := svr.bc.TipHeight()
+	tipRes, err := svr.GetAccount(context.Background(), &iotexapi.GetAccountRequest{Address: addr})
+	require.NoError(err)
+	res, err = svr.GetAccountAtHeight(context.Background(), &apipb.GetAccountAtHeightRequest{Address: addr, Height: tipHeight})
+	require.NoError(err)
+	require.True(proto.Equal(tipRes, res))
+	res, err = svr.GetAccountAtHeight(context.Background(), &apipb.GetAccountAtHeightRequest{Address: addr, Height: 1})
+	require.NoError(err)
+	require.Equal(uint64(1), res.BlockIdentifier.Height)
+	require.Zero(res.AccountMeta.Nonce)
+	require.Equal(uint64(1), res.AccountMeta.PendingNonce)
+	require.NotZero(tipRes.AccountMeta.Nonce)
+	require.NotEqual(tipRes.AccountMeta.Balance, res.AccountMeta.Balance)
+	// the number of actions only counts the actions in the blocks up to the height
+	var numActions uint64
+	for h := uint64(1); h <= tipHeight; h++ {
+		blk, err := svr.dao.GetBlockByHeight(h)
+		require.NoError(err)
+		for _, selp := range blk.Actions {
+			sender, err := address.FromBytes(selp.SrcPubkey().Hash())
+			require.NoError(err)
+			dst, _ := selp.Destination()
+			if sender.String() == addr || dst == addr {
+				numActions++
+			}
+		}
+		res, err = svr.GetAccountAtHeight(context.Background(), &apipb.GetAccountAtHeightRequest{Address: addr, Height: h})
+		require.NoError(err)
+		require.Equal(numActions, res.AccountMeta.NumActions)
+	}
+	require.Equal(tipRes.AccountMeta.NumActions, numActions)
+
+	_, err = svr.ReadContractAtHeight(context.Background(), readRequest)
+	require.NoError(err)
+	_, err = svr.GetAccountAtHeight(context.Background(), &apipb.GetAccountAtHeightRequest{Address: addr, Height: tipHeight + 1})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	// out of history state retention
+	svr.cfg.DB.HistoryStateRetention = tipHeight - 2
+	_, err = svr.GetAccountAtHeight(context.Background(), &apipb.GetAccountAtHeightRequest{Address: addr, Height: 1})
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+	_, err = svr.ReadContractAtHeight(context.Background(), readRequest)
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+	_, err = svr.GetAccountAtHeight(context.Background(), &apipb.GetAccountAtHeightRequest{Address: addr, Height: 2})
+	require.NoError(err)
+}
+
+func TestServer_GetUnverifiedAccountProof(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig(t)
+
+	svr, bfIndexFile, err := createServer(cfg, false)
+	require.NoError(err)
+	addr := identityset.Address(30)
+	_, err = svr.GetUnverifiedAccountProof(context.Background(), &apipb.GetUnverifiedAccountProofRequest{Address: addr.String(), Height: 1})
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+	_, err = svr.GetUnverifiedAccountProof(context.Background(), &apipb.GetUnverifiedAccountProofRequest{Address: "io1abc"})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	res, err := svr.GetUnverifiedAccountProof(context.Background(), &apipb.GetUnverifiedAccountProofRequest{Address: addr.String()})
+	require.NoError(err)
+	require.Equal(svr.bc.TipHeight(), res.Proof.Height)
+	require.Equal(svr.bc.TipHeight(), res.BlockHeader.GetCore().GetHeight())
+	account, err := VerifyAccountProof(res.Proof.RootHash, addr, res.Proof)
+	require.NoError(err)
+	accountRes, err := svr.GetAccount(context.Background(), &iotexapi.GetAccountRequest{Address: addr.String()})
+	require.NoError(err)
+	require.Equal(accountRes.AccountMeta.Balance, account.Balance.String())
+	require.Equal(accountRes.AccountMeta.Nonce, account.Nonce)
+	// the proof does not prove another account
+	_, err = VerifyAccountProof(res.Proof.RootHash, identityset.Address(31), res.Proof)
+	require.Error(err)
+	testutil.CleanupPath(t, bfIndexFile)
+
+	cfg.Chain.EnableArchiveMode = true
+	svr, bfIndexFile, err = createServer(cfg, false)
+	require.NoError(err)
+	defer func() {
+		testutil.CleanupPath(t, bfIndexFile)
+	}()
+	tipRes, err := svr.GetUnverifiedAccountProof(context.Background(), &apipb.GetUnverifiedAccountProofRequest{Address: addr.String()})
+	require.NoError(err)
+	res, err = svr.GetUnverifiedAccountProof(context.Background(), &apipb.GetUnverifiedAccountProofRequest{Address: addr.String(), Height: 1})
+	require.NoError(err)
+	require.Equal(uint64(1), res.Proof.Height)
+	require.NotEqual(tipRes.Proof.RootHash, res.Proof.RootHash)
+	account, err = VerifyAccountProof(res.Proof.RootHash, addr, res.Proof)
+	require.NoError(err)
+	require.Zero(account.Nonce)
+	_, err = VerifyAccountProof(tipRes.Proof.RootHash, addr, res.Proof)
+	require.Error(err)
+	_, err = svr.GetUnverifiedAccountProof(context.Background(), &apipb.GetUnverifiedAccountProofRequest{Address: addr.String(), Height: svr.bc.TipHeight() + 1})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	// proof of absence
+	unknown, err := address.FromBytes([]byte("unknown address 0001"))
+	require.NoError(err)
+	res, err = svr.GetUnverifiedAccountProof(context.Background(), &apipb.GetUnverifiedAccountProofRequest{Address: unknown.String()})
+	require.NoError(err)
+	require.Empty(res.Account)
+	account, err = VerifyAccountProof(res.Proof.RootHash, unknown, res.Proof)
+	require.NoError(err)
+	require.Zero(account.Balance.Sign())
+
+	// storage proof of an account without storage
+	key := "0x" + strings.Repeat("01", 32)
+	storageRes, err := svr.GetUnverifiedStorageProof(context.Background(), &apipb.GetUnverifiedStorageProofRequest{
+		Address: addr.String(),
+		Keys:    []string{key},
+		Height:  1,
+	})
+	require.NoError(err)
+	account, err = VerifyAccountProof(storageRes.AccountProof.Proof.RootHash, addr, storageRes.AccountProof.Proof)
+	require.NoError(err)
+	require.Len(storageRes.StorageProofs, 1)
+	require.Equal(key, storageRes.StorageProofs[0].Key)
+	require.Empty(storageRes.StorageProofs[0].Value)
+	value, err := VerifyStorageProof(account, addr, storageRes.StorageProofs[0])
+	require.NoError(err)
+	require.Nil(value)
+	_, err = svr.GetUnverifiedStorageProof(context.Background(), &apipb.GetUnverifiedStorageProofRequest{Address: addr.String(), Keys: []string{"0x01"}})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
+
 func TestServer_SuggestGasPrice(t *testing.T) {
 	require := require.New(t)
 	cfg := newConfig(t)
@@ -2445,3 +3268,15 @@ func TestServer_GetActPoolActions(t *testing.T) {
 	res, err = svr.GetActPoolActions(context.Background(), &iotexapi.GetActPoolActionsRequest{ActionHashes: []string{hex.EncodeToString(h3[:])}})
 	require.Error(err)
 }
+
+func TestToReceiptPb(t *testing.T) {
+	require := require.New(t)
+
+	receipt := &action.Receipt{Status: uint64(iotextypes.ReceiptStatus_ErrExecutionReverted)}
+	require.Empty(toReceiptPb(receipt).ExecutionRevertMsg)
+	receipt.SetRevertReason("oops")
+	require.Equal("oops", toReceiptPb(receipt).ExecutionRevertMsg)
+	// the revert message in receipt is kept
+	receipt.SetExecutionRevertMsg("reverted")
+	require.Equal("reverted", toReceiptPb(receipt).ExecutionRevertMsg)
+}
@@ -9,6 +9,8 @@ package api
 import (
 	"github.com/iotexproject/iotex-proto/golang/iotexapi"
 	grpc "google.golang.org/grpc"
+
+	"github.com/iotexproject/iotex-core/api/apipb"
 )
 
 // StreamBlocksServer defines the interface of a rpc stream server
@@ -16,3 +18,15 @@ type StreamBlocksServer interface {
 	Send(*iotexapi.StreamBlocksResponse) error
 	grpc.ServerStream
 }
+
+// StreamPendingActionsServer defines the interface of a rpc stream server for pending actions
+type StreamPendingActionsServer interface {
+	Send(*apipb.StreamPendingActionsResponse) error
+	grpc.ServerStream
+}
+
+// StreamBlockEventsServer defines the interface of a rpc stream server for block events
+type StreamBlockEventsServer interface {
+	Send(*apipb.StreamBlockEventsResponse) error
+	grpc.ServerStream
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"sync"
+
+	"github.com/iotexproject/iotex-core/blockchain/block"
+)
+
+// backfillResponder replays the historical blocks from the start height to a responder before the new blocks. The new
+// and reverted blocks received during replay are buffered, and responded after replay in order, skipping the replayed
+// ones, so that there is no gap or duplicate between the historical and new blocks
+type backfillResponder struct {
+	mutex     sync.Mutex
+	inner     Responder
+	start     uint64
+	next      uint64
+	replaying bool
+	pending   []backfillEvent
+	err       error
+}
+
+type backfillEvent struct {
+	blk      *block.Block
+	reverted bool
+}
+
+func newBackfillResponder(r Responder, start uint64) *backfillResponder {
+	return &backfillResponder{
+		inner:     r,
+		start:     start,
+		next:      start,
+		replaying: true,
+	}
+}
+
+// Respond buffers the new block during replay, otherwise responds to it
+func (br *backfillResponder) Respond(blk *block.Block) error {
+	br.mutex.Lock()
+	defer br.mutex.Unlock()
+	if br.err != nil {
+		return br.err
+	}
+	if br.replaying {
+		br.pending = append(br.pending, backfillEvent{blk: blk})
+		return nil
+	}
+	return br.respond(blk)
+}
+
+// RespondRevert buffers the reverted block during replay, otherwise responds to it if the responder responds to reverted
+// blocks
+func (br *backfillResponder) RespondRevert(blk *block.Block) error {
+	br.mutex.Lock()
+	defer br.mutex.Unlock()
+	if br.err != nil {
+		return br.err
+	}
+	if br.replaying {
+		br.pending = append(br.pending, backfillEvent{blk: blk, reverted: true})
+		return nil
+	}
+	return br.respondRevert(blk)
+}
+
+// Exit notifies the responder to exit, unless it has already failed
+func (br *backfillResponder) Exit() {
+	br.mutex.Lock()
+	defer br.mutex.Unlock()
+	if br.err == nil {
+		br.inner.Exit()
+	}
+}
+
+// replay responds to a historical block, which is called in the height order during replay
+func (br *backfillResponder) replay(blk *block.Block) error {
+	if err := br.inner.Respond(blk); err != nil {
+		br.mutex.Lock()
+		br.err = err
+		br.mutex.Unlock()
+		return err
+	}
+	br.mutex.Lock()
+	br.next = blk.Height() + 1
+	br.mutex.Unlock()
+	return nil
+}
+
+// abort stops the replay on a failure of reading the historical blocks, and notifies the responder to exit
+func (br *backfillResponder) abort(err error) {
+	br.mutex.Lock()
+	defer br.mutex.Unlock()
+	if br.err == nil {
+		br.err = err
+		br.inner.Exit()
+	}
+}
+
+// finish responds to the blocks received during the replay up to the height, and then responds to new blocks directly
+func (br *backfillResponder) finish(height uint64) error {
+	br.mutex.Lock()
+	defer br.mutex.Unlock()
+	if br.next <= height {
+		br.next = height + 1
+	}
+	for _, evt := range br.pending {
+		var err error
+		if evt.reverted {
+			err = br.respondRevert(evt.blk)
+		} else {
+			err = br.respond(evt.blk)
+		}
+		if err != nil {
+			break
+		}
+	}
+	br.pending = nil
+	br.replaying = false
+	return br.err
+}
+
+func (br *backfillResponder) respond(blk *block.Block) error {
+	if blk.Height() < br.next {
+		return nil
+	}
+	if err := br.inner.Respond(blk); err != nil {
+		br.err = err
+		return err
+	}
+	br.next = blk.Height() + 1
+	return nil
+}
+
+// respondRevert responds to the reverted block if it has been responded, and the new block at the same height will be
+// responded next
+func (br *backfillResponder) respondRevert(blk *block.Block) error {
+	if blk.Height() >= br.next {
+		return nil
+	}
+	if rr, ok := br.inner.(RevertResponder); ok {
+		if err := rr.RespondRevert(blk); err != nil {
+			br.err = err
+			return err
+		}
+	}
+	br.next = blk.Height()
+	return nil
+}
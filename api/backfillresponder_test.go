@@ -0,0 +1,118 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_apiresponder"
+)
+
+func TestBackfillResponder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	require := require.New(t)
+
+	blks := make([]*block.Block, 7)
+	for i := range blks {
+		blk, err := block.NewTestingBuilder().SetHeight(uint64(i)).SignAndBuild(identityset.PrivateKey(27))
+		require.NoError(err)
+		blks[i] = &blk
+	}
+	var heights []uint64
+	record := func(blk *block.Block) error {
+		heights = append(heights, blk.Height())
+		return nil
+	}
+
+	responder := mock_apiresponder.NewMockResponder(ctrl)
+	responder.EXPECT().Respond(gomock.Any()).DoAndReturn(record).AnyTimes()
+	br := newBackfillResponder(responder, 2)
+	// new blocks are buffered during replay
+	require.NoError(br.Respond(blks[4]))
+	require.NoError(br.Respond(blks[5]))
+	require.Empty(heights)
+	require.NoError(br.replay(blks[2]))
+	require.NoError(br.replay(blks[3]))
+	require.NoError(br.replay(blks[4]))
+	require.NoError(br.finish(4))
+	require.Equal([]uint64{2, 3, 4, 5}, heights)
+	// duplicate blocks are skipped
+	require.NoError(br.Respond(blks[5]))
+	require.NoError(br.Respond(blks[6]))
+	require.Equal([]uint64{2, 3, 4, 5, 6}, heights)
+	responder.EXPECT().Exit().Times(1)
+	br.Exit()
+
+	// the start height is beyond the replayed height
+	heights = nil
+	br = newBackfillResponder(responder, 5)
+	require.NoError(br.Respond(blks[4]))
+	require.NoError(br.finish(3))
+	require.NoError(br.Respond(blks[5]))
+	require.Equal([]uint64{5}, heights)
+
+	// the reverted blocks are responded in order with new blocks
+	var events []string
+	revertResponder := mock_apiresponder.NewMockRevertResponder(ctrl)
+	revertResponder.EXPECT().Respond(gomock.Any()).DoAndReturn(func(blk *block.Block) error {
+		events = append(events, "created "+strconv.FormatUint(blk.Height(), 10))
+		return nil
+	}).AnyTimes()
+	revertResponder.EXPECT().RespondRevert(gomock.Any()).DoAndReturn(func(blk *block.Block) error {
+		events = append(events, "reverted "+strconv.FormatUint(blk.Height(), 10))
+		return nil
+	}).AnyTimes()
+	br = newBackfillResponder(revertResponder, 3)
+	require.NoError(br.RespondRevert(blks[5]))
+	require.NoError(br.RespondRevert(blks[4]))
+	require.NoError(br.Respond(blks[4]))
+	require.NoError(br.replay(blks[3]))
+	require.NoError(br.replay(blks[4]))
+	require.NoError(br.replay(blks[5]))
+	require.NoError(br.finish(5))
+	// the block not responded yet is not reverted
+	require.NoError(br.RespondRevert(blks[6]))
+	require.NoError(br.RespondRevert(blks[4]))
+	require.NoError(br.Respond(blks[4]))
+	require.Equal([]string{
+		"created 3", "created 4", "created 5", "reverted 5", "reverted 4", "created 4", "reverted 4", "created 4",
+	}, events)
+
+	// the responder which does not respond to reverted block gets the new block at the reverted height
+	heights = nil
+	br = newBackfillResponder(responder, 4)
+	require.NoError(br.finish(3))
+	require.NoError(br.Respond(blks[4]))
+	require.NoError(br.RespondRevert(blks[4]))
+	require.NoError(br.Respond(blks[4]))
+	require.Equal([]uint64{4, 4}, heights)
+
+	// the responder fails to respond
+	expectedErr := errors.New("Error when streaming the block")
+	failed := mock_apiresponder.NewMockResponder(ctrl)
+	failed.EXPECT().Respond(gomock.Any()).Return(expectedErr).Times(1)
+	br = newBackfillResponder(failed, 1)
+	require.Equal(expectedErr, br.replay(blks[1]))
+	require.Equal(expectedErr, br.finish(1))
+	require.Equal(expectedErr, br.Respond(blks[2]))
+	br.Exit()
+
+	// the replay is aborted, and the responder exits
+	failed.EXPECT().Exit().Times(1)
+	br = newBackfillResponder(failed, 1)
+	br.abort(expectedErr)
+	require.Equal(expectedErr, br.Respond(blks[2]))
+	br.Exit()
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/iotexproject/iotex-proto/golang/iotexapi"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 
+	"github.com/iotexproject/iotex-core/api/apipb"
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/pkg/log"
 )
@@ -16,6 +17,12 @@ type blockListener struct {
 	errChan chan error
 }
 
+// blockEventListener defines the listener of created and reverted blocks in subscribed through API
+type blockEventListener struct {
+	stream  apipb.APIService_StreamBlockEventsServer
+	errChan chan error
+}
+
 // NewBlockListener returns a new block listener
 func NewBlockListener(stream iotexapi.APIService_StreamBlocksServer, errChan chan error) Responder {
 	return &blockListener{
@@ -26,14 +33,7 @@ func NewBlockListener(stream iotexapi.APIService_StreamBlocksServer, errChan cha
 
 // Respond to new block
 func (bl *blockListener) Respond(blk *block.Block) error {
-	var receiptsPb []*iotextypes.Receipt
-	for _, receipt := range blk.Receipts {
-		receiptsPb = append(receiptsPb, receipt.ConvertToReceiptPb())
-	}
-	blockInfo := &iotexapi.BlockInfo{
-		Block:    blk.ConvertToBlockPb(),
-		Receipts: receiptsPb,
-	}
+	blockInfo := toBlockInfo(blk)
 	// send blockInfo thru streaming API
 	if err := bl.stream.Send(&iotexapi.StreamBlocksResponse{Block: blockInfo}); err != nil {
 		log.L().Info(
@@ -51,3 +51,52 @@ func (bl *blockListener) Respond(blk *block.Block) error {
 func (bl *blockListener) Exit() {
 	bl.errChan <- nil
 }
+
+// NewBlockEventListener returns a new listener of created and reverted blocks
+func NewBlockEventListener(stream apipb.APIService_StreamBlockEventsServer, errChan chan error) RevertResponder {
+	return &blockEventListener{
+		stream:  stream,
+		errChan: errChan,
+	}
+}
+
+// Respond to new block
+func (bl *blockEventListener) Respond(blk *block.Block) error {
+	return bl.send(apipb.BlockEventType_CREATED, blk)
+}
+
+// RespondRevert to reverted block
+func (bl *blockEventListener) RespondRevert(blk *block.Block) error {
+	return bl.send(apipb.BlockEventType_REVERTED, blk)
+}
+
+// Exit send to error channel
+func (bl *blockEventListener) Exit() {
+	bl.errChan <- nil
+}
+
+func (bl *blockEventListener) send(typ apipb.BlockEventType, blk *block.Block) error {
+	if err := bl.stream.Send(&apipb.StreamBlockEventsResponse{Type: typ, Block: toBlockInfo(blk)}); err != nil {
+		log.L().Info(
+			"Error when streaming the block event",
+			zap.Uint64("height", blk.Height()),
+			zap.String("type", typ.String()),
+			zap.Error(err),
+		)
+		bl.errChan <- err
+		return err
+	}
+	return nil
+}
+
+// toBlockInfo converts the block with receipts to block info
+func toBlockInfo(blk *block.Block) *iotexapi.BlockInfo {
+	var receiptsPb []*iotextypes.Receipt
+	for _, receipt := range blk.Receipts {
+		receiptsPb = append(receiptsPb, receipt.ConvertToReceiptPb())
+	}
+	return &iotexapi.BlockInfo{
+		Block:    blk.ConvertToBlockPb(),
+		Receipts: receiptsPb,
+	}
+}
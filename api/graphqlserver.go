@@ -0,0 +1,856 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	graphql "github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/util/httputil"
+)
+
+// graphQLMaxRequestSize is the max size of a graphql request body
+const graphQLMaxRequestSize = 1024 * 1024
+
+// graphQLMaxSafeInteger is the max integer a float64 holds without losing precision, larger numbers must be strings
+const graphQLMaxSafeInteger = 1 << 53
+
+const graphQLSchema = `
+scalar Uint64
+
+schema {
+	query: Query
+}
+
+type Query {
+	chainMeta: ChainMeta!
+	block(height: Uint64, hash: String): Block
+	blocks(start: Uint64!, count: Int!): [Block!]!
+	action(hash: String!): Action
+	actionsByAddress(address: String!, start: Uint64, count: Int!): [Action!]!
+	receipt(actionHash: String!): Receipt
+	logs(fromBlock: Uint64!, toBlock: Uint64!, addresses: [String!], topics: [[String!]!]): [Log!]!
+	account(address: String!, height: Uint64): Account
+	candidates(offset: Int, limit: Int!): [Candidate!]!
+	buckets(voter: String, offset: Int, limit: Int!): [Bucket!]!
+}
+
+type ChainMeta {
+	height: Uint64!
+	numActions: Uint64!
+	tps: Uint64!
+	epoch: Uint64!
+	epochHeight: Uint64!
+}
+
+type Block {
+	height: Uint64!
+	hash: String!
+	timestamp: String!
+	numActions: Int!
+	producerAddress: String!
+	transferAmount: String!
+	txRoot: String!
+	receiptRoot: String!
+	deltaStateDigest: String!
+	previousBlockHash: String!
+	actions(start: Int, count: Int!): [Action!]!
+}
+
+type Action {
+	hash: String!
+	blockHeight: Uint64!
+	blockHash: String!
+	timestamp: String!
+	sender: String!
+	nonce: Uint64!
+	gasLimit: Uint64!
+	gasPrice: String!
+	gasFee: String!
+	type: String!
+	recipient: String
+	amount: String
+	data: String
+	receipt: Receipt
+}
+
+type Receipt {
+	actionHash: String!
+	blockHeight: Uint64!
+	blockHash: String!
+	status: Uint64!
+	gasConsumed: Uint64!
+	contractAddress: String
+	executionRevertMsg: String
+	logs: [Log!]!
+}
+
+type Log {
+	address: String!
+	topics: [String!]!
+	data: String!
+	blockHeight: Uint64!
+	actionHash: String!
+	index: Int!
+}
+
+type Account {
+	address: String!
+	balance: String!
+	nonce: Uint64!
+	pendingNonce: Uint64!
+	numActions: Uint64!
+	isContract: Boolean!
+	actions(start: Uint64, count: Int!): [Action!]!
+}
+
+type Candidate {
+	name: String!
+	ownerAddress: String!
+	operatorAddress: String!
+	rewardAddress: String!
+	totalWeightedVotes: String!
+	selfStakeBucketIdx: Uint64!
+	selfStakingTokens: String!
+}
+
+type Bucket {
+	index: Uint64!
+	candidateAddress: String!
+	owner: String!
+	stakedAmount: String!
+	stakedDuration: Int!
+	createTime: String!
+	stakeStartTime: String!
+	unstakeStartTime: String!
+	autoStake: Boolean!
+}
+`
+
+type (
+	// GraphQLServer provides a read-only graphql query endpoint over http, resolving the queries with the api server.
+	// A query is limited in nesting depth, and in cost which is the total number of objects it resolves, bounded by
+	// the range query limit
+	GraphQLServer struct {
+		core   *Server
+		server http.Server
+		schema *graphql.Schema
+	}
+
+	graphQLRequest struct {
+		Query         string                 `json:"query"`
+		OperationName string                 `json:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+
+	// graphQLCost is the remaining cost budget of a query
+	graphQLCost struct {
+		remaining int64
+	}
+
+	graphQLCostKey struct{}
+
+	// graphQLUint64 is the custom scalar for heights, nonces and gas, which exceed the 32-bit graphql Int
+	graphQLUint64 uint64
+
+	graphQLResolver struct {
+		core *Server
+	}
+
+	chainMetaResolver struct {
+		meta *iotextypes.ChainMeta
+	}
+
+	blockResolver struct {
+		core *Server
+		meta *iotextypes.BlockMeta
+	}
+
+	actionResolver struct {
+		core *Server
+		info *iotexapi.ActionInfo
+	}
+
+	receiptResolver struct {
+		info *iotexapi.ReceiptInfo
+	}
+
+	logResolver struct {
+		log *iotextypes.Log
+	}
+
+	accountResolver struct {
+		core *Server
+		meta *iotextypes.AccountMeta
+	}
+
+	candidateResolver struct {
+		candidate *iotextypes.CandidateV2
+	}
+
+	bucketResolver struct {
+		bucket *iotextypes.VoteBucket
+	}
+)
+
+// NewGraphQLServer creates a new graphql server
+func NewGraphQLServer(core *Server, port int) (*GraphQLServer, error) {
+	opts := []graphql.SchemaOpt{}
+	if core.cfg.API.GraphQLMaxDepth > 0 {
+		opts = append(opts, graphql.MaxDepth(core.cfg.API.GraphQLMaxDepth))
+	}
+	schema, err := graphql.ParseSchema(graphQLSchema, &graphQLResolver{core: core}, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse graphql schema")
+	}
+	gs := &GraphQLServer{
+		core:   core,
+		schema: schema,
+	}
+	gs.server = httputil.Server(":"+strconv.Itoa(port), gs)
+	return gs, nil
+}
+
+// Start starts the graphql server
+func (gs *GraphQLServer) Start(_ context.Context) error {
+	ln, err := httputil.LimitListener(gs.server.Addr)
+	if err != nil {
+		return errors.Wrap(err, "graphql server failed to listen")
+	}
+	log.L().Info("GraphQL server is listening.", zap.String("addr", ln.Addr().String()))
+	go func() {
+		if err := gs.server.Serve(ln); err != nil {
+			log.L().Info("GraphQL server stopped.", zap.Error(err))
+		}
+	}()
+	return nil
+}
+
+// Stop stops the graphql server
+func (gs *GraphQLServer) Stop(ctx context.Context) error {
+	return gs.server.Shutdown(ctx)
+}
+
+// ServeHTTP handles the graphql query in http post body
+func (gs *GraphQLServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	var req graphQLRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, graphQLMaxRequestSize)).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	ctx := context.WithValue(r.Context(), graphQLCostKey{}, &graphQLCost{
+		remaining: int64(gs.core.cfg.API.RangeQueryLimit),
+	})
+	data, err := json.Marshal(gs.schema.Exec(ctx, req.Query, req.OperationName, req.Variables))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		log.L().Warn("Failed to send graphql response.", zap.Error(err))
+	}
+}
+
+// chargeGraphQLCost deducts the cost of n objects from the budget of the query
+func chargeGraphQLCost(ctx context.Context, n int) error {
+	cost, ok := ctx.Value(graphQLCostKey{}).(*graphQLCost)
+	if !ok {
+		return nil
+	}
+	if atomic.AddInt64(&cost.remaining, -int64(n)) < 0 {
+		return errors.New("query cost exceeds the limit")
+	}
+	return nil
+}
+
+// checkCount checks the count of a list query, and charges it to the query cost
+func (r *graphQLResolver) checkCount(ctx context.Context, count int32) error {
+	if count <= 0 {
+		return errors.New("count must be greater than zero")
+	}
+	if uint64(count) > r.core.cfg.API.RangeQueryLimit {
+		return errors.New("range exceeds the limit")
+	}
+	return chargeGraphQLCost(ctx, int(count))
+}
+
+// ImplementsGraphQLType maps the type to the Uint64 scalar
+func (graphQLUint64) ImplementsGraphQLType(name string) bool {
+	return name == "Uint64"
+}
+
+// UnmarshalGraphQL accepts an integer up to 2^53, or a decimal or hex string
+func (u *graphQLUint64) UnmarshalGraphQL(input interface{}) error {
+	switch v := input.(type) {
+	case int32:
+		if v < 0 {
+			return errors.Errorf("invalid Uint64 %d", v)
+		}
+		*u = graphQLUint64(v)
+	case float64:
+		if v < 0 || v > graphQLMaxSafeInteger || v != math.Trunc(v) {
+			return errors.Errorf("invalid Uint64 %v, use a string for the integer larger than %d", v, uint64(graphQLMaxSafeInteger))
+		}
+		*u = graphQLUint64(v)
+	case string:
+		n, err := strconv.ParseUint(v, 0, 64)
+		if err != nil {
+			return errors.Wrapf(err, "invalid Uint64 %s", v)
+		}
+		*u = graphQLUint64(n)
+	default:
+		return errors.Errorf("invalid Uint64 type %T", input)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the value as a json number
+func (u graphQLUint64) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatUint(uint64(u), 10)), nil
+}
+
+func graphQLTime(ts *timestamp.Timestamp) string {
+	t, err := ptypes.Timestamp(ts)
+	if err != nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func (r *graphQLResolver) ChainMeta(ctx context.Context) (*chainMetaResolver, error) {
+	if err := chargeGraphQLCost(ctx, 1); err != nil {
+		return nil, err
+	}
+	res, err := r.core.GetChainMeta(ctx, &iotexapi.GetChainMetaRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &chainMetaResolver{meta: res.ChainMeta}, nil
+}
+
+func (r *graphQLResolver) Block(ctx context.Context, args struct {
+	Height *graphQLUint64
+	Hash   *string
+}) (*blockResolver, error) {
+	if err := chargeGraphQLCost(ctx, 1); err != nil {
+		return nil, err
+	}
+	switch {
+	case args.Hash != nil:
+		res, err := r.core.getBlockMeta(*args.Hash)
+		if err != nil {
+			return nil, err
+		}
+		return &blockResolver{core: r.core, meta: res.BlkMetas[0]}, nil
+	case args.Height != nil:
+		meta, err := r.core.getBlockMetaByHeight(uint64(*args.Height))
+		if err != nil {
+			return nil, err
+		}
+		return &blockResolver{core: r.core, meta: meta}, nil
+	default:
+		return nil, errors.New("either height or hash is required")
+	}
+}
+
+func (r *graphQLResolver) Blocks(ctx context.Context, args struct {
+	Start graphQLUint64
+	Count int32
+}) ([]*blockResolver, error) {
+	if err := r.checkCount(ctx, args.Count); err != nil {
+		return nil, err
+	}
+	res, err := r.core.getBlockMetas(uint64(args.Start), uint64(args.Count))
+	if err != nil {
+		return nil, err
+	}
+	blks := make([]*blockResolver, 0, len(res.BlkMetas))
+	for _, meta := range res.BlkMetas {
+		blks = append(blks, &blockResolver{core: r.core, meta: meta})
+	}
+	return blks, nil
+}
+
+func (r *graphQLResolver) Action(ctx context.Context, args struct{ Hash string }) (*actionResolver, error) {
+	if err := chargeGraphQLCost(ctx, 1); err != nil {
+		return nil, err
+	}
+	res, err := r.core.GetActions(ctx, &iotexapi.GetActionsRequest{
+		Lookup: &iotexapi.GetActionsRequest_ByHash{
+			ByHash: &iotexapi.GetActionByHashRequest{ActionHash: args.Hash, CheckPending: true},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &actionResolver{core: r.core, info: res.ActionInfo[0]}, nil
+}
+
+func (r *graphQLResolver) ActionsByAddress(ctx context.Context, args struct {
+	Address string
+	Start   *graphQLUint64
+	Count   int32
+}) ([]*actionResolver, error) {
+	return actionsByAddress(ctx, r.core, args.Address, args.Start, args.Count)
+}
+
+func (r *graphQLResolver) Receipt(ctx context.Context, args struct{ ActionHash string }) (*receiptResolver, error) {
+	if err := chargeGraphQLCost(ctx, 1); err != nil {
+		return nil, err
+	}
+	res, err := r.core.GetReceiptByAction(ctx, &iotexapi.GetReceiptByActionRequest{ActionHash: args.ActionHash})
+	if err != nil {
+		return nil, err
+	}
+	return &receiptResolver{info: res.ReceiptInfo}, nil
+}
+
+func (r *graphQLResolver) Logs(ctx context.Context, args struct {
+	FromBlock graphQLUint64
+	ToBlock   graphQLUint64
+	Addresses *[]string
+	Topics    *[][]string
+}) ([]*logResolver, error) {
+	if args.FromBlock > args.ToBlock {
+		return nil, errors.Errorf("fromBlock %d is greater than toBlock %d", args.FromBlock, args.ToBlock)
+	}
+	if uint64(args.ToBlock-args.FromBlock) >= r.core.cfg.API.RangeQueryLimit {
+		return nil, errors.New("range exceeds the limit")
+	}
+	// each block in the range is read to filter the logs
+	if err := chargeGraphQLCost(ctx, int(args.ToBlock-args.FromBlock+1)); err != nil {
+		return nil, err
+	}
+	filter := &iotexapi.LogsFilter{}
+	if args.Addresses != nil {
+		filter.Address = *args.Addresses
+	}
+	if args.Topics != nil {
+		for _, topics := range *args.Topics {
+			t := &iotexapi.Topics{}
+			for _, topic := range topics {
+				b, err := hex.DecodeString(topic)
+				if err != nil {
+					return nil, errors.Wrapf(err, "invalid topic %s", topic)
+				}
+				t.Topic = append(t.Topic, b)
+			}
+			filter.Topics = append(filter.Topics, t)
+		}
+	}
+	res, err := r.core.GetLogs(ctx, &iotexapi.GetLogsRequest{
+		Filter: filter,
+		Lookup: &iotexapi.GetLogsRequest_ByRange{
+			ByRange: &iotexapi.GetLogsByRange{FromBlock: uint64(args.FromBlock), ToBlock: uint64(args.ToBlock)},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newLogResolvers(ctx, res.Logs)
+}
+
+func (r *graphQLResolver) Account(ctx context.Context, args struct {
+	Address string
+	Height  *graphQLUint64
+}) (*accountResolver, error) {
+	if err := chargeGraphQLCost(ctx, 1); err != nil {
+		return nil, err
+	}
+	var height uint64
+	if args.Height != nil {
+		height = uint64(*args.Height)
+	}
+	res, err := r.core.getAccount(ctx, args.Address, height)
+	if err != nil {
+		return nil, err
+	}
+	return &accountResolver{core: r.core, meta: res.AccountMeta}, nil
+}
+
+func (r *graphQLResolver) Candidates(ctx context.Context, args struct {
+	Offset *int32
+	Limit  int32
+}) ([]*candidateResolver, error) {
+	pagination, err := r.pagination(ctx, args.Offset, args.Limit)
+	if err != nil {
+		return nil, err
+	}
+	data, err := r.readStakingData(ctx, iotexapi.ReadStakingDataMethod_CANDIDATES, &iotexapi.ReadStakingDataRequest{
+		Request: &iotexapi.ReadStakingDataRequest_Candidates_{
+			Candidates: &iotexapi.ReadStakingDataRequest_Candidates{Pagination: pagination},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var list iotextypes.CandidateListV2
+	if err := proto.Unmarshal(data, &list); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal candidates")
+	}
+	candidates := make([]*candidateResolver, 0, len(list.Candidates))
+	for _, c := range list.Candidates {
+		candidates = append(candidates, &candidateResolver{candidate: c})
+	}
+	return candidates, nil
+}
+
+func (r *graphQLResolver) Buckets(ctx context.Context, args struct {
+	Voter  *string
+	Offset *int32
+	Limit  int32
+}) ([]*bucketResolver, error) {
+	pagination, err := r.pagination(ctx, args.Offset, args.Limit)
+	if err != nil {
+		return nil, err
+	}
+	method := iotexapi.ReadStakingDataMethod_BUCKETS
+	req := &iotexapi.ReadStakingDataRequest{
+		Request: &iotexapi.ReadStakingDataRequest_Buckets{
+			Buckets: &iotexapi.ReadStakingDataRequest_VoteBuckets{Pagination: pagination},
+		},
+	}
+	if args.Voter != nil {
+		method = iotexapi.ReadStakingDataMethod_BUCKETS_BY_VOTER
+		req.Request = &iotexapi.ReadStakingDataRequest_BucketsByVoter{
+			BucketsByVoter: &iotexapi.ReadStakingDataRequest_VoteBucketsByVoter{
+				VoterAddress: *args.Voter,
+				Pagination:   pagination,
+			},
+		}
+	}
+	data, err := r.readStakingData(ctx, method, req)
+	if err != nil {
+		return nil, err
+	}
+	var list iotextypes.VoteBucketList
+	if err := proto.Unmarshal(data, &list); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal buckets")
+	}
+	buckets := make([]*bucketResolver, 0, len(list.Buckets))
+	for _, b := range list.Buckets {
+		buckets = append(buckets, &bucketResolver{bucket: b})
+	}
+	return buckets, nil
+}
+
+func (r *graphQLResolver) pagination(ctx context.Context, offset *int32, limit int32) (*iotexapi.PaginationParam, error) {
+	if err := r.checkCount(ctx, limit); err != nil {
+		return nil, err
+	}
+	pagination := &iotexapi.PaginationParam{Limit: uint32(limit)}
+	if offset != nil {
+		if *offset < 0 {
+			return nil, errors.New("offset must not be negative")
+		}
+		pagination.Offset = uint32(*offset)
+	}
+	return pagination, nil
+}
+
+func (r *graphQLResolver) readStakingData(ctx context.Context, method iotexapi.ReadStakingDataMethod_Name, req *iotexapi.ReadStakingDataRequest) ([]byte, error) {
+	methodName, err := proto.Marshal(&iotexapi.ReadStakingDataMethod{Method: method})
+	if err != nil {
+		return nil, err
+	}
+	arg, err := proto.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := r.core.ReadState(ctx, &iotexapi.ReadStateRequest{
+		ProtocolID: []byte("staking"),
+		MethodName: methodName,
+		Arguments:  [][]byte{arg},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Data, nil
+}
+
+func actionsByAddress(ctx context.Context, core *Server, addr string, start *graphQLUint64, count int32) ([]*actionResolver, error) {
+	r := &graphQLResolver{core: core}
+	if err := r.checkCount(ctx, count); err != nil {
+		return nil, err
+	}
+	var startIndex uint64
+	if start != nil {
+		startIndex = uint64(*start)
+	}
+	res, err := core.getActionsByAddress(addr, startIndex, uint64(count))
+	if err != nil {
+		return nil, err
+	}
+	return newActionResolvers(core, res.ActionInfo), nil
+}
+
+func newActionResolvers(core *Server, infos []*iotexapi.ActionInfo) []*actionResolver {
+	acts := make([]*actionResolver, 0, len(infos))
+	for _, info := range infos {
+		acts = append(acts, &actionResolver{core: core, info: info})
+	}
+	return acts
+}
+
+func newLogResolvers(ctx context.Context, logs []*iotextypes.Log) ([]*logResolver, error) {
+	if err := chargeGraphQLCost(ctx, len(logs)); err != nil {
+		return nil, err
+	}
+	res := make([]*logResolver, 0, len(logs))
+	for _, l := range logs {
+		res = append(res, &logResolver{log: l})
+	}
+	return res, nil
+}
+
+func (r *chainMetaResolver) Height() graphQLUint64 { return graphQLUint64(r.meta.GetHeight()) }
+
+func (r *chainMetaResolver) NumActions() graphQLUint64 { return graphQLUint64(r.meta.GetNumActions()) }
+
+func (r *chainMetaResolver) Tps() graphQLUint64 { return graphQLUint64(r.meta.GetTps()) }
+
+func (r *chainMetaResolver) Epoch() graphQLUint64 { return graphQLUint64(r.meta.GetEpoch().GetNum()) }
+
+func (r *chainMetaResolver) EpochHeight() graphQLUint64 {
+	return graphQLUint64(r.meta.GetEpoch().GetHeight())
+}
+
+func (r *blockResolver) Height() graphQLUint64 { return graphQLUint64(r.meta.Height) }
+
+func (r *blockResolver) Hash() string { return r.meta.Hash }
+
+func (r *blockResolver) Timestamp() string { return graphQLTime(r.meta.Timestamp) }
+
+func (r *blockResolver) NumActions() int32 { return int32(r.meta.NumActions) }
+
+func (r *blockResolver) ProducerAddress() string { return r.meta.ProducerAddress }
+
+func (r *blockResolver) TransferAmount() string { return r.meta.TransferAmount }
+
+func (r *blockResolver) TxRoot() string { return r.meta.TxRoot }
+
+func (r *blockResolver) ReceiptRoot() string { return r.meta.ReceiptRoot }
+
+func (r *blockResolver) DeltaStateDigest() string { return r.meta.DeltaStateDigest }
+
+func (r *blockResolver) PreviousBlockHash() string { return r.meta.PreviousBlockHash }
+
+func (r *blockResolver) Actions(ctx context.Context, args struct {
+	Start *int32
+	Count int32
+}) ([]*actionResolver, error) {
+	if err := (&graphQLResolver{core: r.core}).checkCount(ctx, args.Count); err != nil {
+		return nil, err
+	}
+	var start int64
+	if args.Start != nil {
+		if *args.Start < 0 {
+			return nil, errors.New("start must not be negative")
+		}
+		start = int64(*args.Start)
+	}
+	if start >= r.meta.NumActions {
+		return []*actionResolver{}, nil
+	}
+	res, err := r.core.getActionsByBlock(r.meta.Hash, uint64(start), uint64(args.Count))
+	if err != nil {
+		return nil, err
+	}
+	return newActionResolvers(r.core, res.ActionInfo), nil
+}
+
+func (r *actionResolver) Hash() string { return r.info.ActHash }
+
+func (r *actionResolver) BlockHeight() graphQLUint64 { return graphQLUint64(r.info.BlkHeight) }
+
+func (r *actionResolver) BlockHash() string { return r.info.BlkHash }
+
+func (r *actionResolver) Timestamp() string { return graphQLTime(r.info.Timestamp) }
+
+func (r *actionResolver) Sender() string { return r.info.Sender }
+
+func (r *actionResolver) Nonce() graphQLUint64 {
+	return graphQLUint64(r.info.GetAction().GetCore().GetNonce())
+}
+
+func (r *actionResolver) GasLimit() graphQLUint64 {
+	return graphQLUint64(r.info.GetAction().GetCore().GetGasLimit())
+}
+
+func (r *actionResolver) GasPrice() string { return r.info.GetAction().GetCore().GetGasPrice() }
+
+func (r *actionResolver) GasFee() string { return r.info.GasFee }
+
+// Type returns the name of the action in the action core, e.g. transfer, execution, stakeCreate
+func (r *actionResolver) Type() string {
+	return actionTypeName(r.info.GetAction().GetCore())
+}
+
+func (r *actionResolver) Recipient() *string {
+	core := r.info.GetAction().GetCore()
+	switch {
+	case core.GetTransfer() != nil:
+		return optionalString(core.GetTransfer().GetRecipient())
+	case core.GetExecution() != nil:
+		return optionalString(core.GetExecution().GetContract())
+	}
+	return nil
+}
+
+func (r *actionResolver) Amount() *string {
+	core := r.info.GetAction().GetCore()
+	switch {
+	case core.GetTransfer() != nil:
+		return optionalString(core.GetTransfer().GetAmount())
+	case core.GetExecution() != nil:
+		return optionalString(core.GetExecution().GetAmount())
+	}
+	return nil
+}
+
+func (r *actionResolver) Data() *string {
+	core := r.info.GetAction().GetCore()
+	switch {
+	case core.GetTransfer() != nil:
+		return optionalString(hex.EncodeToString(core.GetTransfer().GetPayload()))
+	case core.GetExecution() != nil:
+		return optionalString(hex.EncodeToString(core.GetExecution().GetData()))
+	}
+	return nil
+}
+
+// Receipt returns the receipt of the action, or null if the action is pending
+func (r *actionResolver) Receipt(ctx context.Context) (*receiptResolver, error) {
+	if r.info.BlkHeight == 0 {
+		return nil, nil
+	}
+	return (&graphQLResolver{core: r.core}).Receipt(ctx, struct{ ActionHash string }{r.info.ActHash})
+}
+
+func (r *receiptResolver) ActionHash() string { return hex.EncodeToString(r.info.Receipt.ActHash) }
+
+func (r *receiptResolver) BlockHeight() graphQLUint64 { return graphQLUint64(r.info.Receipt.BlkHeight) }
+
+func (r *receiptResolver) BlockHash() string { return r.info.BlkHash }
+
+func (r *receiptResolver) Status() graphQLUint64 { return graphQLUint64(r.info.Receipt.Status) }
+
+func (r *receiptResolver) GasConsumed() graphQLUint64 {
+	return graphQLUint64(r.info.Receipt.GasConsumed)
+}
+
+func (r *receiptResolver) ContractAddress() *string {
+	return optionalString(r.info.Receipt.ContractAddress)
+}
+
+func (r *receiptResolver) ExecutionRevertMsg() *string {
+	return optionalString(r.info.Receipt.ExecutionRevertMsg)
+}
+
+func (r *receiptResolver) Logs(ctx context.Context) ([]*logResolver, error) {
+	return newLogResolvers(ctx, r.info.Receipt.Logs)
+}
+
+func (r *logResolver) Address() string { return r.log.ContractAddress }
+
+func (r *logResolver) Topics() []string {
+	topics := make([]string, 0, len(r.log.Topics))
+	for _, t := range r.log.Topics {
+		topics = append(topics, hex.EncodeToString(t))
+	}
+	return topics
+}
+
+func (r *logResolver) Data() string { return hex.EncodeToString(r.log.Data) }
+
+func (r *logResolver) BlockHeight() graphQLUint64 { return graphQLUint64(r.log.BlkHeight) }
+
+func (r *logResolver) ActionHash() string { return hex.EncodeToString(r.log.ActHash) }
+
+func (r *logResolver) Index() int32 { return int32(r.log.Index) }
+
+func (r *accountResolver) Address() string { return r.meta.Address }
+
+func (r *accountResolver) Balance() string { return r.meta.Balance }
+
+func (r *accountResolver) Nonce() graphQLUint64 { return graphQLUint64(r.meta.Nonce) }
+
+func (r *accountResolver) PendingNonce() graphQLUint64 { return graphQLUint64(r.meta.PendingNonce) }
+
+func (r *accountResolver) NumActions() graphQLUint64 { return graphQLUint64(r.meta.NumActions) }
+
+func (r *accountResolver) IsContract() bool { return r.meta.IsContract }
+
+func (r *accountResolver) Actions(ctx context.Context, args struct {
+	Start *graphQLUint64
+	Count int32
+}) ([]*actionResolver, error) {
+	return actionsByAddress(ctx, r.core, r.meta.Address, args.Start, args.Count)
+}
+
+func (r *candidateResolver) Name() string { return r.candidate.Name }
+
+func (r *candidateResolver) OwnerAddress() string { return r.candidate.OwnerAddress }
+
+func (r *candidateResolver) OperatorAddress() string { return r.candidate.OperatorAddress }
+
+func (r *candidateResolver) RewardAddress() string { return r.candidate.RewardAddress }
+
+func (r *candidateResolver) TotalWeightedVotes() string { return r.candidate.TotalWeightedVotes }
+
+func (r *candidateResolver) SelfStakeBucketIdx() graphQLUint64 {
+	return graphQLUint64(r.candidate.SelfStakeBucketIdx)
+}
+
+func (r *candidateResolver) SelfStakingTokens() string { return r.candidate.SelfStakingTokens }
+
+func (r *bucketResolver) Index() graphQLUint64 { return graphQLUint64(r.bucket.Index) }
+
+func (r *bucketResolver) CandidateAddress() string { return r.bucket.CandidateAddress }
+
+func (r *bucketResolver) Owner() string { return r.bucket.Owner }
+
+func (r *bucketResolver) StakedAmount() string { return r.bucket.StakedAmount }
+
+func (r *bucketResolver) StakedDuration() int32 { return int32(r.bucket.StakedDuration) }
+
+func (r *bucketResolver) CreateTime() string { return graphQLTime(r.bucket.CreateTime) }
+
+func (r *bucketResolver) StakeStartTime() string { return graphQLTime(r.bucket.StakeStartTime) }
+
+func (r *bucketResolver) UnstakeStartTime() string { return graphQLTime(r.bucket.UnstakeStartTime) }
+
+func (r *bucketResolver) AutoStake() bool { return r.bucket.AutoStake }
@@ -0,0 +1,204 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestGraphQLServer(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig(t)
+	cfg.API.RangeQueryLimit = 100
+	cfg.API.GraphQLMaxDepth = 4
+
+	svr, bfIndexFile, err := createServer(cfg, false)
+	require.NoError(err)
+	defer func() {
+		testutil.CleanupPath(t, bfIndexFile)
+	}()
+	gs, err := NewGraphQLServer(svr, 0)
+	require.NoError(err)
+
+	type response struct {
+		Data   map[string]json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	query := func(q string) *response {
+		body, err := json.Marshal(graphQLRequest{Query: q})
+		require.NoError(err)
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(body)))
+		rec := httptest.NewRecorder()
+		gs.ServeHTTP(rec, req)
+		require.Equal(http.StatusOK, rec.Code)
+		var res response
+		require.NoError(json.Unmarshal(rec.Body.Bytes(), &res))
+		return &res
+	}
+	result := func(q string, field string, v interface{}) {
+		res := query(q)
+		require.Empty(res.Errors)
+		require.NoError(json.Unmarshal(res.Data[field], v))
+	}
+
+	// only post is allowed
+	rec := httptest.NewRecorder()
+	gs.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(http.StatusMethodNotAllowed, rec.Code)
+
+	var meta struct{ Height uint64 }
+	result(`{ chainMeta { height } }`, "chainMeta", &meta)
+	require.Equal(svr.bc.TipHeight(), meta.Height)
+
+	// block with the actions and receipts
+	var blk struct {
+		Height     uint64
+		Hash       string
+		NumActions int
+		Actions    []struct {
+			Hash      string
+			Sender    string
+			Type      string
+			Recipient *string
+			Amount    *string
+			Receipt   struct {
+				Status      uint64
+				BlockHeight uint64
+			}
+		}
+	}
+	result(`{ block(height: 1) { height hash numActions actions(count: 10) { hash sender type recipient amount receipt { status blockHeight } } } }`, "block", &blk)
+	require.EqualValues(1, blk.Height)
+	require.Equal(blkHash[1], blk.Hash)
+	require.Equal(blk.NumActions, len(blk.Actions))
+	act := blk.Actions[0]
+	require.Equal(hex.EncodeToString(transferHash1[:]), act.Hash)
+	require.Equal(identityset.Address(27).String(), act.Sender)
+	require.Equal("transfer", act.Type)
+	require.Equal(identityset.Address(30).String(), *act.Recipient)
+	require.Equal("10", *act.Amount)
+	require.EqualValues(1, act.Receipt.Status)
+	require.EqualValues(1, act.Receipt.BlockHeight)
+	var blkByHash struct{ Height uint64 }
+	result(`{ block(hash: "`+blkHash[1]+`") { height } }`, "block", &blkByHash)
+	require.EqualValues(1, blkByHash.Height)
+
+	var blks []struct{ Height uint64 }
+	result(`{ blocks(start: 1, count: 2) { height } }`, "blocks", &blks)
+	require.Len(blks, 2)
+	require.EqualValues(2, blks[1].Height)
+
+	// account with its actions
+	var account struct {
+		Address    string
+		Balance    string
+		NumActions uint64
+		Actions    []struct{ Hash string }
+	}
+	addr := identityset.Address(30).String()
+	result(`{ account(address: "`+addr+`") { address balance numActions actions(count: 2) { hash } } }`, "account", &account)
+	res, err := svr.getAccount(context.Background(), addr, 0)
+	require.NoError(err)
+	require.Equal(addr, account.Address)
+	require.Equal(res.AccountMeta.Balance, account.Balance)
+	require.Equal(res.AccountMeta.NumActions, account.NumActions)
+	require.Len(account.Actions, 2)
+	require.Equal(hex.EncodeToString(transferHash1[:]), account.Actions[0].Hash)
+
+	var receipt struct {
+		ActionHash string
+		Logs       []struct{ Address string }
+	}
+	result(`{ receipt(actionHash: "`+hex.EncodeToString(transferHash1[:])+`") { actionHash logs { address } } }`, "receipt", &receipt)
+	require.Equal(hex.EncodeToString(transferHash1[:]), receipt.ActionHash)
+	require.Empty(receipt.Logs)
+
+	var logs []struct{ BlockHeight uint64 }
+	result(`{ logs(fromBlock: 1, toBlock: 4) { blockHeight } }`, "logs", &logs)
+
+	// staking protocol is not registered in the test chain
+	r := query(`{ candidates(limit: 10) { name } }`)
+	require.Len(r.Errors, 1)
+	require.Contains(r.Errors[0].Message, "staking")
+
+	// invalid arguments
+	for _, q := range []string{
+		`{ blocks(start: 1, count: 0) { height } }`,
+		`{ blocks(start: 1, count: 101) { height } }`,
+		`{ logs(fromBlock: 1, toBlock: 101) { blockHeight } }`,
+		`{ block { height } }`,
+		`{ account(address: "invalid") { address } }`,
+	} {
+		r := query(q)
+		require.NotEmpty(r.Errors, q)
+	}
+
+	// query depth exceeds the limit
+	r = query(`{ block(height: 1) { actions(count: 1) { receipt { logs { address } } } } }`)
+	require.NotEmpty(r.Errors)
+	require.Contains(r.Errors[0].Message, "depth")
+
+	// query cost exceeds the limit, though each list is within the range limit
+	r = query(`{ a: blocks(start: 1, count: 60) { height } b: blocks(start: 1, count: 60) { height } }`)
+	require.NotEmpty(r.Errors)
+	require.Contains(r.Errors[0].Message, "cost")
+	r = query(`{ blocks(start: 1, count: ` + strconv.Itoa(int(svr.bc.TipHeight())) + `) { height } }`)
+	require.Empty(r.Errors)
+	// the blocks in the range of logs are charged even if there is no log
+	r = query(`{ a: logs(fromBlock: 1, toBlock: 60) { blockHeight } b: logs(fromBlock: 1, toBlock: 60) { blockHeight } }`)
+	require.NotEmpty(r.Errors)
+	require.Contains(r.Errors[0].Message, "cost")
+}
+
+func TestGraphQLUint64(t *testing.T) {
+	require := require.New(t)
+
+	for _, c := range []struct {
+		input interface{}
+		value uint64
+	}{
+		{int32(1), 1},
+		{float64(1 << 40), 1 << 40},
+		{float64(1 << 53), 1 << 53},
+		{"18446744073709551615", math.MaxUint64},
+		{"0x10", 16},
+	} {
+		var u graphQLUint64
+		require.NoError(u.UnmarshalGraphQL(c.input), c.input)
+		require.Equal(c.value, uint64(u))
+	}
+	for _, input := range []interface{}{
+		int32(-1),
+		float64(-1),
+		1.5,
+		// the float64 larger than 2^53 may have lost precision
+		float64(1<<53 + 2),
+		float64(math.MaxUint64),
+		1e30,
+		"-1",
+		"18446744073709551616",
+		true,
+	} {
+		var u graphQLUint64
+		require.Error(u.UnmarshalGraphQL(input), input)
+	}
+}
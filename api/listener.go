@@ -14,11 +14,12 @@ var (
 )
 
 type (
-	// Listener pass new block to all responders
+	// Listener pass new block to all responders, and reverted block to the responders which respond to it
 	Listener interface {
 		Start() error
 		Stop() error
 		ReceiveBlock(*block.Block) error
+		RevertBlock(*block.Block) error
 		AddResponder(Responder) error
 	}
 
@@ -71,6 +72,22 @@ func (cl *chainListener) ReceiveBlock(blk *block.Block) error {
 	return nil
 }
 
+// RevertBlock handles the block reverted from the tip of chain
+func (cl *chainListener) RevertBlock(blk *block.Block) error {
+	// pass the block to every responder which responds to reverted block
+	cl.streamMap.Range(func(key cache.Key, _ interface{}) bool {
+		r, ok := key.(RevertResponder)
+		if !ok {
+			return true
+		}
+		if err := r.RespondRevert(blk); err != nil {
+			cl.streamMap.Remove(key)
+		}
+		return true
+	})
+	return nil
+}
+
 // AddResponder adds a new responder
 func (cl *chainListener) AddResponder(r Responder) error {
 	_, loaded := cl.streamMap.Get(r)
@@ -58,3 +58,27 @@ func TestChainListener(t *testing.T) {
 	err = listener.Stop()
 	require.NoError(t, err)
 }
+
+func TestChainListener_RevertBlock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	require := require.New(t)
+
+	listener := NewChainListener()
+	blk := &block.Block{}
+	// the responder which does not respond to reverted block is skipped
+	responder := mock_apiresponder.NewMockResponder(ctrl)
+	require.NoError(listener.AddResponder(responder))
+	revertResponder := mock_apiresponder.NewMockRevertResponder(ctrl)
+	require.NoError(listener.AddResponder(revertResponder))
+	revertResponder.EXPECT().RespondRevert(blk).Return(nil).Times(1)
+	require.NoError(listener.RevertBlock(blk))
+
+	// the responder is removed once it fails to respond
+	revertResponder.EXPECT().RespondRevert(blk).Return(errors.New("Error when streaming the block event")).Times(1)
+	require.NoError(listener.RevertBlock(blk))
+	require.NoError(listener.RevertBlock(blk))
+
+	responder.EXPECT().Exit().Return().Times(1)
+	require.NoError(listener.Stop())
+}
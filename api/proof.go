@@ -0,0 +1,67 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
+	"github.com/iotexproject/iotex-core/api/apipb"
+	"github.com/iotexproject/iotex-core/db/trie"
+	"github.com/iotexproject/iotex-core/db/trie/mptrie"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/state/factory"
+)
+
+// VerifyAccountProof verifies the proof returned by GetUnverifiedAccountProof against the root hash of the state trie,
+// and returns the proved account, which is an empty account if the proof shows the account does not exist.
+// The block header does not include the root hash of the state trie, so the proof cannot be verified against the
+// endorsed header. The verification only shows the account is consistent with the given root hash, which must come
+// from a trusted source, passing the root hash in the proof itself proves nothing about the chain state
+func VerifyAccountProof(rootHash []byte, addr address.Address, proof *apipb.StateProof) (*state.Account, error) {
+	if proof == nil {
+		return nil, errors.Wrap(mptrie.ErrInvalidProof, "empty proof")
+	}
+	addrHash := hash.BytesToHash160(addr.Bytes())
+	value, err := factory.VerifyStateProof(rootHash, factory.AccountKVNamespace, addrHash[:], &factory.StateProof{
+		Height:         proof.GetHeight(),
+		RootHash:       proof.GetRootHash(),
+		NamespaceProof: proof.GetNamespaceProof(),
+		KeyProof:       proof.GetKeyProof(),
+	})
+	account := state.EmptyAccount()
+	switch errors.Cause(err) {
+	case nil:
+		if err := state.Deserialize(&account, value); err != nil {
+			return nil, errors.Wrap(mptrie.ErrInvalidProof, err.Error())
+		}
+	case state.ErrStateNotExist:
+	default:
+		return nil, err
+	}
+	return &account, nil
+}
+
+// VerifyStorageProof verifies the proof returned by GetUnverifiedStorageProof against the storage root of the contract
+// account, which should be verified by VerifyAccountProof first, and returns the value of the slot, which is nil if
+// the proof shows the slot does not exist
+func VerifyStorageProof(account *state.Account, addr address.Address, proof *apipb.StorageProof) ([]byte, error) {
+	if account == nil || proof == nil {
+		return nil, errors.Wrap(mptrie.ErrInvalidProof, "empty proof")
+	}
+	key, err := decodeStorageSlot(proof.GetKey())
+	if err != nil {
+		return nil, err
+	}
+	value, err := evm.VerifyStorageProof(account.Root, hash.BytesToHash160(addr.Bytes()), hash.BytesToHash256(key[:]), proof.GetProof())
+	if errors.Cause(err) == trie.ErrNotExist {
+		return nil, nil
+	}
+	return value, err
+}
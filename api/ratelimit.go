@@ -0,0 +1,172 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"math"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/pkg/ratelimit"
+)
+
+// apiKeyMetadata is the grpc metadata key of the api key
+const apiKeyMetadata = "x-api-key"
+
+// rateLimitPruneInterval is the interval to remove the refilled buckets of idle clients
+const rateLimitPruneInterval = time.Minute
+
+var rateLimitMtc = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "iotex_api_rate_limit_rejection",
+	Help: "Number of api calls rejected by rate limit.",
+}, []string{"method", "client"})
+
+func init() {
+	prometheus.MustRegister(rateLimitMtc)
+}
+
+type (
+	// rateLimiter limits the rate of grpc calls and web3 requests per api key if the client sends a known key, otherwise
+	// per client ip
+	rateLimiter struct {
+		keys      map[string]struct{}
+		ipLimit   *ratelimit.Limiter
+		keyLimit  *ratelimit.Limiter
+		weights   map[string]uint64
+		rangeCost func(from, to uint64) uint64
+	}
+
+	countRequest interface {
+		GetCount() uint64
+	}
+)
+
+// newRateLimiter creates a rate limiter of grpc calls, rangeCost returns the cost of querying the height range
+func newRateLimiter(cfg config.APIRateLimit, rangeCost func(from, to uint64) uint64, opts ...ratelimit.Option) *rateLimiter {
+	keys := make(map[string]struct{}, len(cfg.APIKeys))
+	for _, k := range cfg.APIKeys {
+		keys[k] = struct{}{}
+	}
+	opts = append([]ratelimit.Option{ratelimit.WithPruneInterval(rateLimitPruneInterval)}, opts...)
+	return &rateLimiter{
+		keys:      keys,
+		ipLimit:   ratelimit.New(cfg.IPAvg, cfg.IPBurst, opts...),
+		keyLimit:  ratelimit.New(cfg.KeyAvg, cfg.KeyBurst, opts...),
+		weights:   cfg.MethodWeights,
+		rangeCost: rangeCost,
+	}
+}
+
+// UnaryServerInterceptor rejects the call if the client exceeds its rate limit
+func (rl *rateLimiter) UnaryServerInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if err := rl.allow(ctx, info.FullMethod, weightedCost(rl.weight(info.FullMethod), rl.cost(req))); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor rejects the stream if the client exceeds its rate limit
+func (rl *rateLimiter) StreamServerInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if err := rl.allow(ss.Context(), info.FullMethod, rl.weight(info.FullMethod)); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+func (rl *rateLimiter) allow(ctx context.Context, method string, cost uint64) error {
+	client, limiter, label := "", rl.ipLimit, "ip"
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if keys := md.Get(apiKeyMetadata); len(keys) > 0 {
+			if _, ok := rl.keys[keys[0]]; ok {
+				client, limiter, label = keys[0], rl.keyLimit, "key"
+			}
+		}
+	}
+	if client == "" {
+		client = clientIP(ctx)
+	}
+	if limiter.AllowN(client, cost) {
+		return nil
+	}
+	rateLimitMtc.WithLabelValues(method, label).Inc()
+	return status.Errorf(codes.ResourceExhausted, "rate limit exceeded for %s", method)
+}
+
+// weight returns the configured weight of the method, which is 1 if not configured
+func (rl *rateLimiter) weight(fullMethod string) uint64 {
+	if w, ok := rl.weights[fullMethod[strings.LastIndex(fullMethod, "/")+1:]]; ok {
+		return w
+	}
+	return 1
+}
+
+// cost returns the size of the range for range queries, and 1 for others, which is multiplied by the method weight
+func (rl *rateLimiter) cost(req interface{}) uint64 {
+	var cost uint64
+	switch r := req.(type) {
+	case *iotexapi.GetLogsRequest:
+		if byRange := r.GetByRange(); byRange != nil {
+			cost = rl.rangeCost(byRange.GetFromBlock(), byRange.GetToBlock())
+		}
+	case *iotexapi.GetBlockMetasRequest:
+		cost = r.GetByIndex().GetCount()
+	case *iotexapi.GetActionsRequest:
+		for _, sub := range []countRequest{r.GetByIndex(), r.GetByAddr(), r.GetUnconfirmedByAddr(), r.GetByBlk()} {
+			cost += sub.GetCount()
+		}
+	case countRequest:
+		cost = r.GetCount()
+	}
+	if cost == 0 {
+		return 1
+	}
+	return cost
+}
+
+// weightedCost multiplies the cost by the weight of method, the cost of a client-controlled count must not wrap around
+// to a small number, so it saturates at the max uint64
+func weightedCost(weight, cost uint64) uint64 {
+	if weight != 0 && cost > math.MaxUint64/weight {
+		return math.MaxUint64
+	}
+	return weight * cost
+}
+
+// clientIP returns the ip of the peer of the call
+func clientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
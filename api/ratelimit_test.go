@@ -0,0 +1,138 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"math"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+
+	"github.com/iotexproject/iotex-core/api/apipb"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/pkg/ratelimit"
+)
+
+func TestRateLimiter(t *testing.T) {
+	require := require.New(t)
+
+	cfg := config.Default.API.RateLimit
+	cfg.IPAvg = 1
+	cfg.IPBurst = 10
+	cfg.KeyAvg = 1
+	cfg.KeyBurst = 100
+	cfg.APIKeys = []string{"key"}
+	cfg.MethodWeights = map[string]uint64{"ReadContract": 5, "GetRawBlocks": 2}
+	now := time.Unix(1000, 0)
+	rl := newRateLimiter(cfg, func(from, to uint64) uint64 {
+		if to == 0 {
+			to = 20
+		}
+		return to - from + 1
+	}, ratelimit.WithClock(func() time.Time { return now }))
+
+	// cost of calls
+	for _, c := range []struct {
+		req  interface{}
+		cost uint64
+	}{
+		{&iotexapi.GetAccountRequest{}, 1},
+		{&iotexapi.GetLogsRequest{Lookup: &iotexapi.GetLogsRequest_ByRange{
+			ByRange: &iotexapi.GetLogsByRange{FromBlock: 11, ToBlock: 0},
+		}}, 10},
+		{&iotexapi.GetLogsRequest{Lookup: &iotexapi.GetLogsRequest_ByBlock{}}, 1},
+		{&iotexapi.GetRawBlocksRequest{Count: 5}, 5},
+		{&iotexapi.GetBlockMetasRequest{Lookup: &iotexapi.GetBlockMetasRequest_ByIndex{
+			ByIndex: &iotexapi.GetBlockMetasByIndexRequest{Count: 7},
+		}}, 7},
+		{&iotexapi.GetActionsRequest{Lookup: &iotexapi.GetActionsRequest_ByAddr{
+			ByAddr: &iotexapi.GetActionsByAddressRequest{Count: 3},
+		}}, 3},
+		{&apipb.GetTransfersByAddressRequest{Count: 4}, 4},
+	} {
+		require.Equal(c.cost, rl.cost(c.req))
+	}
+
+	// weights of methods
+	for _, c := range []struct {
+		method string
+		weight uint64
+	}{
+		{"/iotexapi.APIService/ReadContract", 5},
+		{"/iotexapi.APIService/GetRawBlocks", 2},
+		{"/iotexapi.APIService/GetAccount", 1},
+		{"/apipb.APIService/ReadContractAtHeight", 1},
+	} {
+		require.Equal(c.weight, rl.weight(c.method))
+	}
+
+	var handled int
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		handled++
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/iotexapi.APIService/GetLogs"}
+	call := func(ctx context.Context, req interface{}) error {
+		_, err := rl.UnaryServerInterceptor(ctx, req, info, handler)
+		return err
+	}
+	withIP := func(ip string) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 1234}})
+	}
+	logs := &iotexapi.GetLogsRequest{Lookup: &iotexapi.GetLogsRequest_ByRange{
+		ByRange: &iotexapi.GetLogsByRange{FromBlock: 1, ToBlock: 8},
+	}}
+
+	// limited per ip
+	ctx := withIP("10.0.0.1")
+	require.NoError(call(ctx, logs))
+	err := call(ctx, logs)
+	require.Equal(codes.ResourceExhausted, status.Code(err))
+	require.NoError(call(withIP("10.0.0.2"), logs))
+	require.Equal(2, handled)
+
+	// a known api key is limited per key, unknown keys are limited per ip
+	keyCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(apiKeyMetadata, "key"))
+	for i := 0; i < 10; i++ {
+		require.NoError(call(keyCtx, logs))
+	}
+	unknownCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(apiKeyMetadata, "unknown"))
+	require.Equal(codes.ResourceExhausted, status.Code(call(unknownCtx, logs)))
+
+	// refilled
+	now = now.Add(8 * time.Second)
+	require.NoError(call(ctx, logs))
+	require.Equal(13, handled)
+
+	// the weight of method is multiplied by the size of range
+	now = now.Add(10 * time.Second)
+	info.FullMethod = "/iotexapi.APIService/GetRawBlocks"
+	require.NoError(call(ctx, &iotexapi.GetRawBlocksRequest{Count: 3}))
+	require.Equal(codes.ResourceExhausted, status.Code(call(ctx, &iotexapi.GetRawBlocksRequest{Count: 3})))
+	require.NoError(call(ctx, &iotexapi.GetRawBlocksRequest{Count: 2}))
+	require.Equal(15, handled)
+
+	// the weighted cost of a huge count saturates rather than wrapping around to 0
+	require.Equal(uint64(math.MaxUint64), weightedCost(2, 1<<63))
+	require.Equal(uint64(math.MaxUint64), weightedCost(3, 1<<63))
+	require.Equal(uint64(1<<63), weightedCost(1, 1<<63))
+	require.Equal(uint64(0), weightedCost(0, 1<<63))
+	now = now.Add(10 * time.Second)
+	require.NoError(call(ctx, &iotexapi.GetRawBlocksRequest{Count: 1 << 63}))
+	require.Equal(codes.ResourceExhausted, status.Code(call(ctx, &iotexapi.GetRawBlocksRequest{Count: 1})))
+	require.Equal(16, handled)
+}
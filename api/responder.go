@@ -7,6 +7,7 @@
 package api
 
 import (
+	"github.com/iotexproject/iotex-core/actpool"
 	"github.com/iotexproject/iotex-core/blockchain/block"
 )
 
@@ -15,3 +16,15 @@ type Responder interface {
 	Respond(*block.Block) error
 	Exit()
 }
+
+// RevertResponder is a responder which also responds to the block reverted from the tip of chain
+type RevertResponder interface {
+	Responder
+	RespondRevert(*block.Block) error
+}
+
+// ActionResponder responds to the event of pending action
+type ActionResponder interface {
+	Respond(*actpool.ActionEvent) error
+	Exit()
+}
@@ -0,0 +1,816 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/proto"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/api/logfilter"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/util/httputil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+)
+
+// JSON-RPC 2.0 error codes, and the codes of execution revert and limit exceeded used by Ethereum clients
+const (
+	web3ParseError        = -32700
+	web3InvalidRequest    = -32600
+	web3MethodNotFound    = -32601
+	web3InvalidParams     = -32602
+	web3ServerError       = -32000
+	web3LimitExceeded     = -32005
+	web3ExecutionReverted = 3
+)
+
+// web3MaxRequestSize is the max size of a http request body
+const web3MaxRequestSize = 5 * 1024 * 1024
+
+var errSubscriptionClosed = errors.New("subscription closed")
+
+type (
+	// Web3Server provides Ethereum JSON-RPC compatible api over http and websocket, by translating the requests to
+	// the api server
+	Web3Server struct {
+		core       *Server
+		server     http.Server
+		upgrader   websocket.Upgrader
+		queueSize  int
+		batchLimit int
+		subID      uint64
+	}
+
+	web3Request struct {
+		JSONRPC string          `json:"jsonrpc"`
+		ID      json.RawMessage `json:"id"`
+		Method  string          `json:"method"`
+		Params  json.RawMessage `json:"params"`
+	}
+
+	web3Response struct {
+		JSONRPC string          `json:"jsonrpc"`
+		ID      json.RawMessage `json:"id"`
+		Result  json.RawMessage `json:"result,omitempty"`
+		Error   *web3Error      `json:"error,omitempty"`
+	}
+
+	web3Error struct {
+		Code    int         `json:"code"`
+		Message string      `json:"message"`
+		Data    interface{} `json:"data,omitempty"`
+	}
+
+	web3Notification struct {
+		JSONRPC string                 `json:"jsonrpc"`
+		Method  string                 `json:"method"`
+		Params  web3NotificationParams `json:"params"`
+	}
+
+	web3NotificationParams struct {
+		Subscription string      `json:"subscription"`
+		Result       interface{} `json:"result"`
+	}
+
+	// web3Subscription responds to new blocks with the block headers or the matched logs
+	web3Subscription struct {
+		id       string
+		conn     *wsConn
+		filter   *logfilter.LogFilter
+		gasLimit uint64
+		closed   int32
+	}
+)
+
+func (e *web3Error) Error() string {
+	return e.Message
+}
+
+func newWeb3Error(code int, format string, args ...interface{}) *web3Error {
+	return &web3Error{
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// NewWeb3Server creates a new web3 server on the given port
+func NewWeb3Server(core *Server, port int) *Web3Server {
+	ws := &Web3Server{
+		core: core,
+		upgrader: websocket.Upgrader{
+			CheckOrigin: func(*http.Request) bool { return true },
+		},
+		queueSize:  core.cfg.API.WebsocketQueueSize,
+		batchLimit: core.cfg.API.Web3BatchLimit,
+	}
+	if ws.queueSize <= 0 {
+		ws.queueSize = 1
+	}
+	if ws.batchLimit <= 0 {
+		ws.batchLimit = 1
+	}
+	ws.server = httputil.Server(":"+strconv.Itoa(port), ws)
+	return ws
+}
+
+// Start starts the web3 server
+func (ws *Web3Server) Start(_ context.Context) error {
+	ln, err := httputil.LimitListener(ws.server.Addr)
+	if err != nil {
+		return errors.Wrap(err, "web3 server failed to listen")
+	}
+	log.L().Info("Web3 server is listening.", zap.String("addr", ln.Addr().String()))
+	go func() {
+		if err := ws.server.Serve(ln); err != nil {
+			log.L().Info("Web3 server stopped.", zap.Error(err))
+		}
+	}()
+	return nil
+}
+
+// Stop stops the web3 server
+func (ws *Web3Server) Stop(ctx context.Context) error {
+	return ws.server.Shutdown(ctx)
+}
+
+// ServeHTTP handles JSON-RPC requests in http post body, or upgrades the connection to websocket
+func (ws *Web3Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if websocket.IsWebSocketUpgrade(r) {
+		ws.serveWebsocket(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	data, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, web3MaxRequestSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(ws.handleMessage(web3ClientContext(r), nil, data)); err != nil {
+		log.L().Warn("Failed to send web3 response.", zap.Error(err))
+	}
+}
+
+func (ws *Web3Server) serveWebsocket(w http.ResponseWriter, r *http.Request) {
+	conn, err := ws.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.L().Warn("Failed to upgrade web3 connection.", zap.Error(err))
+		return
+	}
+	conn.SetReadLimit(web3MaxRequestSize)
+	// the responses and notifications are written by the writer of connection, which drops the client if it is too
+	// slow to receive them
+	wc := newWsConn(conn, ws.queueSize)
+	go wc.writeLoop()
+	defer wc.close()
+	ctx := web3ClientContext(r)
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		if err := wc.enqueue(ws.handleMessage(ctx, wc, data)); err != nil {
+			return
+		}
+	}
+}
+
+// web3ClientContext attaches the api key in the "x-api-key" header and the remote address of the http request to the
+// context as grpc does, so that the requests are limited per client the same as grpc calls
+func web3ClientContext(r *http.Request) context.Context {
+	ctx := peer.NewContext(r.Context(), &peer.Peer{Addr: web3RemoteAddr(r.RemoteAddr)})
+	if key := r.Header.Get(apiKeyMetadata); key != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(apiKeyMetadata, key))
+	}
+	return ctx
+}
+
+// web3RemoteAddr is the remote address of http request in "host:port" format
+type web3RemoteAddr string
+
+func (a web3RemoteAddr) Network() string { return "tcp" }
+
+func (a web3RemoteAddr) String() string { return string(a) }
+
+// handleMessage handles a single request or a batch of requests, every request in a batch is charged to the rate
+// limit of the client
+func (ws *Web3Server) handleMessage(ctx context.Context, wc *wsConn, data []byte) []byte {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		var reqs []json.RawMessage
+		if err := json.Unmarshal(data, &reqs); err != nil {
+			return marshalWeb3Response(nil, nil, newWeb3Error(web3ParseError, "%s", err.Error()))
+		}
+		if len(reqs) == 0 {
+			return marshalWeb3Response(nil, nil, newWeb3Error(web3InvalidRequest, "empty batch"))
+		}
+		if len(reqs) > ws.batchLimit {
+			return marshalWeb3Response(nil, nil, newWeb3Error(web3InvalidRequest, "batch of %d requests exceeds the limit %d", len(reqs), ws.batchLimit))
+		}
+		res := make([]json.RawMessage, 0, len(reqs))
+		for _, req := range reqs {
+			res = append(res, ws.handleRequest(ctx, wc, req))
+		}
+		out, _ := json.Marshal(res)
+		return out
+	}
+	return ws.handleRequest(ctx, wc, data)
+}
+
+func (ws *Web3Server) handleRequest(ctx context.Context, wc *wsConn, data []byte) []byte {
+	var req web3Request
+	if err := json.Unmarshal(data, &req); err != nil {
+		return marshalWeb3Response(nil, nil, newWeb3Error(web3ParseError, "%s", err.Error()))
+	}
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		return marshalWeb3Response(req.ID, nil, newWeb3Error(web3InvalidRequest, "invalid request"))
+	}
+	if rl := ws.core.rl; rl != nil {
+		if err := rl.allow(ctx, req.Method, rl.weight(req.Method)); err != nil {
+			return marshalWeb3Response(req.ID, nil, err)
+		}
+	}
+	var params []json.RawMessage
+	if len(req.Params) > 0 && string(req.Params) != "null" {
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return marshalWeb3Response(req.ID, nil, newWeb3Error(web3InvalidParams, "params should be an array"))
+		}
+	}
+	var (
+		res interface{}
+		err error
+	)
+	switch req.Method {
+	case "web3_clientVersion":
+		res = "iotex-core/" + version.PackageVersion
+	case "net_version":
+		res = strconv.FormatUint(uint64(ws.core.cfg.Genesis.EVMNetworkID), 10)
+	case "net_listening":
+		res = true
+	case "eth_chainId":
+		res = hexutil.EncodeUint64(uint64(ws.core.cfg.Genesis.EVMNetworkID))
+	case "eth_blockNumber":
+		res = hexutil.EncodeUint64(ws.core.bc.TipHeight())
+	case "eth_gasPrice":
+		res, err = ws.gasPrice()
+	case "eth_getBalance":
+		res, err = ws.getBalance(ctx, params)
+	case "eth_getTransactionCount":
+		res, err = ws.getTransactionCount(ctx, params)
+	case "eth_call":
+		res, err = ws.call(ctx, params)
+	case "eth_estimateGas":
+		res, err = ws.estimateGas(ctx, params)
+	case "eth_getLogs":
+		res, err = ws.getLogs(ctx, params)
+	case "eth_sendRawTransaction":
+		res, err = ws.sendRawTransaction(ctx, params)
+	case "eth_getTransactionReceipt":
+		res, err = ws.getTransactionReceipt(params)
+	case "eth_subscribe":
+		res, err = ws.subscribe(wc, params)
+	case "eth_unsubscribe":
+		res, err = ws.unsubscribe(wc, params)
+	default:
+		err = newWeb3Error(web3MethodNotFound, "the method %s does not exist", req.Method)
+	}
+	return marshalWeb3Response(req.ID, res, err)
+}
+
+func marshalWeb3Response(id json.RawMessage, res interface{}, err error) []byte {
+	resp := &web3Response{
+		JSONRPC: "2.0",
+		ID:      id,
+	}
+	if len(resp.ID) == 0 {
+		resp.ID = json.RawMessage("null")
+	}
+	if err != nil {
+		resp.Error = toWeb3Error(err)
+	} else {
+		result, err := json.Marshal(res)
+		if err != nil {
+			resp.Error = newWeb3Error(web3ServerError, "%s", err.Error())
+		} else {
+			resp.Result = result
+		}
+	}
+	out, _ := json.Marshal(resp)
+	return out
+}
+
+func toWeb3Error(err error) *web3Error {
+	if e, ok := errors.Cause(err).(*web3Error); ok {
+		return e
+	}
+	if sta, ok := status.FromError(err); ok {
+		if sta.Code() == codes.ResourceExhausted {
+			return newWeb3Error(web3LimitExceeded, "%s", sta.Message())
+		}
+		return newWeb3Error(web3ServerError, "%s", sta.Message())
+	}
+	return newWeb3Error(web3ServerError, "%s", err.Error())
+}
+
+// parseWeb3Params decodes the positional params into the targets, missing params are left untouched
+func parseWeb3Params(params []json.RawMessage, required int, targets ...interface{}) error {
+	if len(params) < required {
+		return newWeb3Error(web3InvalidParams, "missing value for required argument %d", len(params))
+	}
+	if len(params) > len(targets) {
+		return newWeb3Error(web3InvalidParams, "too many arguments, want at most %d", len(targets))
+	}
+	for i, param := range params {
+		if err := json.Unmarshal(param, targets[i]); err != nil {
+			return newWeb3Error(web3InvalidParams, "invalid argument %d: %s", i, err.Error())
+		}
+	}
+	return nil
+}
+
+func (ws *Web3Server) gasPrice() (interface{}, error) {
+	price, err := ws.core.gs.SuggestGasPrice()
+	if err != nil {
+		return nil, err
+	}
+	return hexutil.EncodeUint64(price), nil
+}
+
+func (ws *Web3Server) getAccountMeta(ctx context.Context, params []json.RawMessage) (*iotextypes.AccountMeta, string, error) {
+	var addr, tag string
+	if err := parseWeb3Params(params, 1, &addr, &tag); err != nil {
+		return nil, "", err
+	}
+	ioAddr, err := ethAddrToIoAddr(addr)
+	if err != nil {
+		return nil, "", newWeb3Error(web3InvalidParams, "%s", err.Error())
+	}
+	height, err := parseWeb3BlockNumber(tag, ws.core.bc.TipHeight())
+	if err != nil {
+		return nil, "", newWeb3Error(web3InvalidParams, "invalid block number: %s", err.Error())
+	}
+	if height == ws.core.bc.TipHeight() {
+		height = 0
+	}
+	// the account is read from the state, which does not require the action index
+	meta, _, err := ws.core.accountState(ioAddr, height)
+	if err != nil {
+		return nil, "", err
+	}
+	return meta, tag, nil
+}
+
+func (ws *Web3Server) getBalance(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	meta, _, err := ws.getAccountMeta(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	balance, ok := new(big.Int).SetString(meta.Balance, 10)
+	if !ok {
+		return nil, errors.Errorf("invalid balance %s", meta.Balance)
+	}
+	return hexutil.EncodeBig(balance), nil
+}
+
+// getTransactionCount returns the nonce of the next action to send from the account
+func (ws *Web3Server) getTransactionCount(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	meta, tag, err := ws.getAccountMeta(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if tag == "pending" {
+		return hexutil.EncodeUint64(meta.PendingNonce), nil
+	}
+	return hexutil.EncodeUint64(meta.Nonce + 1), nil
+}
+
+// parseCallObject decodes the call object, the block tag and the optional state override of eth_call and
+// eth_estimateGas
+func parseCallObject(params []json.RawMessage) (*web3Call, error) {
+	var (
+		call      web3CallObject
+		tag       string
+		overrides web3StateOverride
+		err       error
+	)
+	if err = parseWeb3Params(params, 1, &call, &tag, &overrides); err != nil {
+		return nil, err
+	}
+	res := &web3Call{tag: tag}
+	if call.From == "" {
+		zeroAddr, _ := address.FromBytes(make([]byte, 20))
+		res.from = zeroAddr.String()
+	} else if res.from, err = ethAddrToIoAddr(call.From); err != nil {
+		return nil, newWeb3Error(web3InvalidParams, "%s", err.Error())
+	}
+	if call.To != "" {
+		if res.to, err = ethAddrToIoAddr(call.To); err != nil {
+			return nil, newWeb3Error(web3InvalidParams, "%s", err.Error())
+		}
+	}
+	if res.value, err = parseWeb3Quantity(call.Value); err != nil {
+		return nil, newWeb3Error(web3InvalidParams, "invalid value: %s", err.Error())
+	}
+	if res.value == nil {
+		res.value = big.NewInt(0)
+	}
+	if call.Data != "" {
+		if res.data, err = hexutil.Decode(call.Data); err != nil {
+			return nil, newWeb3Error(web3InvalidParams, "invalid data: %s", err.Error())
+		}
+	}
+	if res.override, err = overrides.toStateOverride(); err != nil {
+		return nil, newWeb3Error(web3InvalidParams, "invalid state override: %s", err.Error())
+	}
+	return res, nil
+}
+
+func (ws *Web3Server) call(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	call, err := parseCallObject(params)
+	if err != nil {
+		return nil, err
+	}
+	height, err := parseWeb3BlockNumber(call.tag, ws.core.bc.TipHeight())
+	if err != nil {
+		return nil, newWeb3Error(web3InvalidParams, "invalid block number: %s", err.Error())
+	}
+	res, err := ws.core.readContract(call.execution(), call.from, height, call.override)
+	if err != nil {
+		return nil, err
+	}
+	switch res.Receipt.Status {
+	case uint64(iotextypes.ReceiptStatus_Success):
+		return "0x" + res.Data, nil
+	case uint64(iotextypes.ReceiptStatus_ErrExecutionReverted):
+		// the revert payload is returned in the data of error as Ethereum clients do
+		e := newWeb3Error(web3ExecutionReverted, "execution reverted")
+		if reason := res.Receipt.ExecutionRevertMsg; reason != "" {
+			e.Message += ": " + reason
+		}
+		e.Data = "0x" + res.Data
+		return nil, e
+	default:
+		return nil, newWeb3Error(web3ServerError, "execution failed with status %d", res.Receipt.Status)
+	}
+}
+
+func (ws *Web3Server) estimateGas(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	call, err := parseCallObject(params)
+	if err != nil {
+		return nil, err
+	}
+	isExecution := call.to == "" || len(call.data) > 0
+	if call.override != nil && isExecution {
+		res, err := ws.core.estimateActionGasConsumptionForExecution(call.execution(), call.from, call.override)
+		if err != nil {
+			return nil, err
+		}
+		return hexutil.EncodeUint64(res.Gas), nil
+	}
+	req := &iotexapi.EstimateActionGasConsumptionRequest{CallerAddress: call.from}
+	if isExecution {
+		req.Action = &iotexapi.EstimateActionGasConsumptionRequest_Execution{
+			Execution: call.execution(),
+		}
+	} else {
+		req.Action = &iotexapi.EstimateActionGasConsumptionRequest_Transfer{
+			Transfer: &iotextypes.Transfer{
+				Amount:    call.value.String(),
+				Recipient: call.to,
+			},
+		}
+	}
+	res, err := ws.core.EstimateActionGasConsumption(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return hexutil.EncodeUint64(res.Gas), nil
+}
+
+func (ws *Web3Server) getLogs(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var filter web3FilterObject
+	if err := parseWeb3Params(params, 1, &filter); err != nil {
+		return nil, err
+	}
+	logsFilter, err := filter.toLogsFilter()
+	if err != nil {
+		return nil, newWeb3Error(web3InvalidParams, "%s", err.Error())
+	}
+	req := &iotexapi.GetLogsRequest{Filter: logsFilter}
+	if filter.BlockHash != "" {
+		h, err := parseWeb3Hash(filter.BlockHash)
+		if err != nil {
+			return nil, newWeb3Error(web3InvalidParams, "invalid block hash: %s", err.Error())
+		}
+		req.Lookup = &iotexapi.GetLogsRequest_ByBlock{
+			ByBlock: &iotexapi.GetLogsByBlock{BlockHash: h[:]},
+		}
+	} else {
+		tipHeight := ws.core.bc.TipHeight()
+		from, err := parseWeb3BlockNumber(filter.FromBlock, tipHeight)
+		if err != nil {
+			return nil, newWeb3Error(web3InvalidParams, "invalid fromBlock: %s", err.Error())
+		}
+		to, err := parseWeb3BlockNumber(filter.ToBlock, tipHeight)
+		if err != nil {
+			return nil, newWeb3Error(web3InvalidParams, "invalid toBlock: %s", err.Error())
+		}
+		if from > to {
+			return nil, newWeb3Error(web3InvalidParams, "fromBlock %d is greater than toBlock %d", from, to)
+		}
+		req.Lookup = &iotexapi.GetLogsRequest_ByRange{
+			ByRange: &iotexapi.GetLogsByRange{FromBlock: from, ToBlock: to},
+		}
+	}
+	res, err := ws.core.GetLogs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	blks := make(map[uint64]*block.Block)
+	logs := make([]*web3Log, 0, len(res.Logs))
+	for _, l := range res.Logs {
+		blk, ok := blks[l.BlkHeight]
+		if !ok {
+			if blk, err = ws.core.dao.GetBlockByHeight(l.BlkHeight); err != nil {
+				return nil, err
+			}
+			blks[l.BlkHeight] = blk
+		}
+		wl, err := toWeb3Log(l, blk.HashBlock(), actionIndexInBlock(blk, l.ActHash))
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, wl)
+	}
+	return logs, nil
+}
+
+// sendRawTransaction sends an RLP-encoded Ethereum transaction signed per EIP-155, or a serialized IoTeX action
+func (ws *Web3Server) sendRawTransaction(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var raw string
+	if err := parseWeb3Params(params, 1, &raw); err != nil {
+		return nil, err
+	}
+	data, err := hexutil.Decode(raw)
+	if err != nil {
+		return nil, newWeb3Error(web3InvalidParams, "invalid raw transaction: %s", err.Error())
+	}
+	act := &iotextypes.Action{}
+	if len(data) > 0 && data[0] >= 0xc0 {
+		// an RLP-encoded transaction is a list
+		selp, err := action.DecodeRawTx(data, ws.core.cfg.Genesis.EVMNetworkID)
+		if err != nil {
+			return nil, newWeb3Error(web3InvalidParams, "%s", err.Error())
+		}
+		act = selp.Proto()
+	} else if err := proto.Unmarshal(data, act); err != nil {
+		return nil, newWeb3Error(web3InvalidParams, "invalid raw transaction: %s", err.Error())
+	}
+	res, err := ws.core.SendAction(ctx, &iotexapi.SendActionRequest{Action: act})
+	if err != nil {
+		return nil, err
+	}
+	return "0x" + res.ActionHash, nil
+}
+
+// getTransactionReceipt returns the receipt of action, or null if the action is not committed yet
+func (ws *Web3Server) getTransactionReceipt(params []json.RawMessage) (interface{}, error) {
+	var h string
+	if err := parseWeb3Params(params, 1, &h); err != nil {
+		return nil, err
+	}
+	actHash, err := parseWeb3Hash(h)
+	if err != nil {
+		return nil, newWeb3Error(web3InvalidParams, "invalid transaction hash: %s", err.Error())
+	}
+	if ws.core.indexer == nil {
+		return nil, errors.New("action index is not available")
+	}
+	actIndex, err := ws.core.indexer.GetActionIndex(actHash[:])
+	if err != nil {
+		if errors.Cause(err) == db.ErrNotExist {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return ws.toWeb3Receipt(actHash, actIndex.BlockHeight())
+}
+
+func (ws *Web3Server) toWeb3Receipt(actHash hash.Hash256, height uint64) (*web3Receipt, error) {
+	blk, err := ws.core.dao.GetBlockByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := ws.core.dao.GetReceipts(height)
+	if err != nil {
+		return nil, err
+	}
+	idx := actionIndexInBlock(blk, actHash[:])
+	if idx < 0 || idx >= len(receipts) {
+		return nil, errors.Errorf("cannot find action %x in block %d", actHash, height)
+	}
+	var cumulativeGasUsed uint64
+	for _, r := range receipts[:idx+1] {
+		cumulativeGasUsed += r.GasConsumed
+	}
+	selp, receipt := blk.Actions[idx], receipts[idx]
+	blkHash := blk.HashBlock()
+	sender, err := address.FromBytes(selp.SrcPubkey().Hash())
+	if err != nil {
+		return nil, err
+	}
+	from, err := ioAddrToEthAddr(sender.String())
+	if err != nil {
+		return nil, err
+	}
+	res := &web3Receipt{
+		TransactionHash:   web3Hash(actHash[:]),
+		TransactionIndex:  hexutil.EncodeUint64(uint64(idx)),
+		BlockHash:         web3Hash(blkHash[:]),
+		BlockNumber:       hexutil.EncodeUint64(height),
+		From:              from,
+		CumulativeGasUsed: hexutil.EncodeUint64(cumulativeGasUsed),
+		GasUsed:           hexutil.EncodeUint64(receipt.GasConsumed),
+		Logs:              []*web3Log{},
+		Status:            "0x0",
+	}
+	if receipt.Status == uint64(iotextypes.ReceiptStatus_Success) {
+		res.Status = "0x1"
+	}
+	if dst, ok := selp.Destination(); ok && dst != "" {
+		to, err := ioAddrToEthAddr(dst)
+		if err != nil {
+			return nil, err
+		}
+		res.To = &to
+	}
+	// the contract address of receipt is the callee or the protocol address other than contract deployment
+	if exec, ok := selp.Action().(*action.Execution); ok && exec.Contract() == action.EmptyAddress && receipt.ContractAddress != "" {
+		contract, err := ioAddrToEthAddr(receipt.ContractAddress)
+		if err != nil {
+			return nil, err
+		}
+		res.ContractAddress = &contract
+	}
+	for _, l := range receipt.Logs() {
+		wl, err := toWeb3Log(l.ConvertToLogPb(), blkHash, idx)
+		if err != nil {
+			return nil, err
+		}
+		res.Logs = append(res.Logs, wl)
+	}
+	if res.LogsBloom, err = web3LogsBloom(receipt.Logs()); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// subscribe subscribes to "newHeads" or "logs" matching the filter, only available over websocket
+func (ws *Web3Server) subscribe(wc *wsConn, params []json.RawMessage) (interface{}, error) {
+	if wc == nil {
+		return nil, newWeb3Error(web3ServerError, "notifications not supported")
+	}
+	var (
+		typ    string
+		filter web3FilterObject
+	)
+	if err := parseWeb3Params(params, 1, &typ, &filter); err != nil {
+		return nil, err
+	}
+	sub := &web3Subscription{
+		id:       hexutil.EncodeUint64(atomic.AddUint64(&ws.subID, 1)),
+		conn:     wc,
+		gasLimit: ws.core.cfg.Genesis.BlockGasLimit,
+	}
+	switch typ {
+	case "newHeads":
+	case "logs":
+		logsFilter, err := filter.toLogsFilter()
+		if err != nil {
+			return nil, newWeb3Error(web3InvalidParams, "%s", err.Error())
+		}
+		sub.filter = logfilter.NewLogFilter(logsFilter, nil, nil)
+	default:
+		return nil, newWeb3Error(web3InvalidParams, "unsupported subscription %s", typ)
+	}
+	if err := ws.core.chainListener.AddResponder(sub); err != nil {
+		return nil, err
+	}
+	wc.mutex.Lock()
+	wc.subs[sub.id] = sub
+	wc.mutex.Unlock()
+	return sub.id, nil
+}
+
+func (ws *Web3Server) unsubscribe(wc *wsConn, params []json.RawMessage) (interface{}, error) {
+	if wc == nil {
+		return nil, newWeb3Error(web3ServerError, "notifications not supported")
+	}
+	var id string
+	if err := parseWeb3Params(params, 1, &id); err != nil {
+		return nil, err
+	}
+	if err := wc.unsubscribe(id); err != nil {
+		return false, nil
+	}
+	return true, nil
+}
+
+// Respond sends the block header or the matched logs in new block
+func (sub *web3Subscription) Respond(blk *block.Block) error {
+	if atomic.LoadInt32(&sub.closed) == 1 {
+		return errSubscriptionClosed
+	}
+	if sub.filter == nil {
+		return sub.notify(toWeb3BlockHeader(blk, sub.gasLimit))
+	}
+	return sub.notifyLogs(blk, false)
+}
+
+// RespondRevert sends the matched logs in reverted block as removed, the reverted block headers are not sent
+func (sub *web3Subscription) RespondRevert(blk *block.Block) error {
+	if atomic.LoadInt32(&sub.closed) == 1 {
+		return errSubscriptionClosed
+	}
+	if sub.filter == nil {
+		return nil
+	}
+	return sub.notifyLogs(blk, true)
+}
+
+func (sub *web3Subscription) notifyLogs(blk *block.Block, removed bool) error {
+	if !sub.filter.ExistInBloomFilter(blk.LogsBloomfilter()) {
+		return nil
+	}
+	blkHash := blk.HashBlock()
+	for _, l := range sub.filter.MatchLogs(blk.Receipts) {
+		wl, err := toWeb3Log(l, blkHash, actionIndexInBlock(blk, l.ActHash))
+		if err != nil {
+			return err
+		}
+		wl.Removed = removed
+		if err := sub.notify(wl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Exit closes the connection when chain listener stops
+func (sub *web3Subscription) Exit() {
+	sub.conn.close()
+}
+
+func (sub *web3Subscription) close() {
+	atomic.StoreInt32(&sub.closed, 1)
+}
+
+func (sub *web3Subscription) notify(result interface{}) error {
+	data, err := json.Marshal(&web3Notification{
+		JSONRPC: "2.0",
+		Method:  "eth_subscription",
+		Params: web3NotificationParams{
+			Subscription: sub.id,
+			Result:       result,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if err := sub.conn.enqueue(data); err != nil {
+		sub.close()
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,268 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/proto"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockindex"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/ratelimit"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestWeb3AddressConversion(t *testing.T) {
+	require := require.New(t)
+
+	ioAddr := identityset.Address(27).String()
+	ethAddr, err := ioAddrToEthAddr(ioAddr)
+	require.NoError(err)
+	require.True(strings.HasPrefix(ethAddr, "0x"))
+	addr, err := ethAddrToIoAddr(ethAddr)
+	require.NoError(err)
+	require.Equal(ioAddr, addr)
+	addr, err = ethAddrToIoAddr(strings.ToLower(ethAddr))
+	require.NoError(err)
+	require.Equal(ioAddr, addr)
+	// io address is accepted as it is
+	addr, err = ethAddrToIoAddr(ioAddr)
+	require.NoError(err)
+	require.Equal(ioAddr, addr)
+
+	for _, invalid := range []string{"", "0x123", "io1abc"} {
+		_, err = ethAddrToIoAddr(invalid)
+		require.Error(err)
+	}
+
+	var filter web3FilterObject
+	require.NoError(json.Unmarshal([]byte(`{"address":"`+ethAddr+`","topics":[null,["0x`+strings.Repeat("ab", 32)+`"]]}`), &filter))
+	logsFilter, err := filter.toLogsFilter()
+	require.NoError(err)
+	require.Equal([]string{ioAddr}, logsFilter.Address)
+	require.Len(logsFilter.Topics, 2)
+	require.Empty(logsFilter.Topics[0].Topic)
+	require.Len(logsFilter.Topics[1].Topic, 1)
+}
+
+func TestWeb3Server(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig(t)
+	// the execution revert is distinguished from other failures since Bering height
+	cfg.Genesis.BeringBlockHeight = 0
+
+	svr, bfIndexFile, err := createServer(cfg, false)
+	require.NoError(err)
+	defer func() {
+		testutil.CleanupPath(t, bfIndexFile)
+	}()
+	ws := NewWeb3Server(svr, 0)
+
+	post := func(body string) []byte {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		ws.ServeHTTP(rec, req)
+		require.Equal(http.StatusOK, rec.Code)
+		return rec.Body.Bytes()
+	}
+	call := func(method string, params string) *web3Response {
+		var res web3Response
+		require.NoError(json.Unmarshal(post(`{"jsonrpc":"2.0","id":1,"method":"`+method+`","params":`+params+`}`), &res))
+		require.Equal("1", string(res.ID))
+		return &res
+	}
+	result := func(method string, params string) string {
+		res := call(method, params)
+		require.Nil(res.Error)
+		return string(res.Result)
+	}
+
+	require.Equal(`"0x1251"`, result("eth_chainId", "[]"))
+	require.Equal(`"4689"`, result("net_version", "[]"))
+	require.Equal(`"`+hexutil.EncodeUint64(svr.bc.TipHeight())+`"`, result("eth_blockNumber", "[]"))
+
+	ethAddr, err := ioAddrToEthAddr(identityset.Address(27).String())
+	require.NoError(err)
+	account, err := svr.GetAccount(context.Background(), &iotexapi.GetAccountRequest{Address: identityset.Address(27).String()})
+	require.NoError(err)
+	var balance string
+	require.NoError(json.Unmarshal([]byte(result("eth_getBalance", `["`+ethAddr+`","latest"]`)), &balance))
+	b, err := hexutil.DecodeBig(balance)
+	require.NoError(err)
+	require.Equal(account.AccountMeta.Balance, b.String())
+	// the account is read without the action index
+	indexer := svr.indexer
+	svr.indexer = nil
+	require.Equal(`"`+balance+`"`, result("eth_getBalance", `["`+ethAddr+`","latest"]`))
+	require.Equal(`"`+hexutil.EncodeUint64(account.AccountMeta.PendingNonce)+`"`, result("eth_getTransactionCount", `["`+ethAddr+`","pending"]`))
+	svr.indexer = indexer
+
+	// receipt of a committed action
+	var receipt web3Receipt
+	require.NoError(json.Unmarshal([]byte(result("eth_getTransactionReceipt", `["0x`+hex.EncodeToString(transferHash1[:])+`"]`)), &receipt))
+	require.Equal("0x"+hex.EncodeToString(transferHash1[:]), receipt.TransactionHash)
+	require.Equal("0x1", receipt.BlockNumber)
+	require.Equal("0x1", receipt.Status)
+	require.Equal(ethAddr, receipt.From)
+	require.NotNil(receipt.To)
+	require.Nil(receipt.ContractAddress)
+	require.Equal(hexutil.Encode(make([]byte, types.BloomByteLength)), receipt.LogsBloom)
+	// receipt of an unknown action is null
+	require.Equal("null", result("eth_getTransactionReceipt", `["0x`+strings.Repeat("00", 32)+`"]`))
+	// the error of action index other than not exist is returned
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	kv := db.NewMockKVStoreWithRange(ctrl)
+	kv.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, errors.New("failed to read action index")).Times(1)
+	svr.indexer, err = blockindex.NewIndexer(kv, hash.ZeroHash256)
+	require.NoError(err)
+	res := call("eth_getTransactionReceipt", `["0x`+hex.EncodeToString(transferHash1[:])+`"]`)
+	require.Equal(web3ServerError, res.Error.Code)
+	svr.indexer = indexer
+
+	// the logs bloom of receipt contains the addresses and topics of logs
+	var numLogs int
+	for h := uint64(1); h <= svr.bc.TipHeight(); h++ {
+		receipts, err := svr.dao.GetReceipts(h)
+		require.NoError(err)
+		for _, r := range receipts {
+			if len(r.Logs()) == 0 {
+				continue
+			}
+			receipt = web3Receipt{}
+			require.NoError(json.Unmarshal([]byte(result("eth_getTransactionReceipt", `["0x`+hex.EncodeToString(r.ActionHash[:])+`"]`)), &receipt))
+			require.Len(receipt.Logs, len(r.Logs()))
+			numLogs += len(receipt.Logs)
+			data, err := hexutil.Decode(receipt.LogsBloom)
+			require.NoError(err)
+			bloom := types.BytesToBloom(data)
+			for _, l := range receipt.Logs {
+				require.True(bloom.Test(new(big.Int).SetBytes(common.HexToAddress(l.Address).Bytes())))
+				for _, topic := range l.Topics {
+					require.True(bloom.Test(new(big.Int).SetBytes(common.HexToHash(topic).Bytes())))
+				}
+			}
+		}
+	}
+	require.NotZero(numLogs)
+
+	// call with state override, the contract returns the value of slot 0
+	contract, err := ioAddrToEthAddr(identityset.Address(29).String())
+	require.NoError(err)
+	value := "0x" + strings.Repeat("00", 31) + "2a"
+	override := `{"` + contract + `":{"code":"0x60005460005260206000f3","stateDiff":{"0x` + strings.Repeat("00", 32) + `":"` + value + `"}}}`
+	require.Equal(`"`+value+`"`, result("eth_call", `[{"from":"`+ethAddr+`","to":"`+contract+`"},"latest",`+override+`]`))
+	require.Equal(`"0x"`, result("eth_call", `[{"from":"`+ethAddr+`","to":"`+contract+`"},"latest"]`))
+	res = call("eth_call", `[{"to":"`+contract+`"},"latest",{"`+contract+`":{"nonce":"1"}}]`)
+	require.Equal(web3InvalidParams, res.Error.Code)
+	// the revert payload is returned in the error, the contract reverts with 42 in the return data
+	override = `{"` + contract + `":{"code":"0x602a60005260206000fd"}}`
+	res = call("eth_call", `[{"from":"`+ethAddr+`","to":"`+contract+`"},"latest",`+override+`]`)
+	require.Nil(res.Result)
+	require.Equal(web3ExecutionReverted, res.Error.Code)
+	require.Equal("execution reverted", res.Error.Message)
+	require.Equal("0x"+strings.Repeat("00", 31)+"2a", res.Error.Data)
+
+	// send an Ethereum transaction
+	svr.broadcastHandler = func(context.Context, uint32, proto.Message) error { return nil }
+	raw, err := testutil.SignedRawTx(identityset.Address(28).String(), identityset.PrivateKey(27), account.AccountMeta.PendingNonce,
+		big.NewInt(1), testutil.TestGasLimit, big.NewInt(testutil.TestGasPriceInt64), nil, cfg.Genesis.EVMNetworkID)
+	require.NoError(err)
+	selp, err := action.DecodeRawTx(raw, cfg.Genesis.EVMNetworkID)
+	require.NoError(err)
+	h := selp.Hash()
+	require.Equal(`"0x`+hex.EncodeToString(h[:])+`"`, result("eth_sendRawTransaction", `["`+hexutil.Encode(raw)+`"]`))
+	_, err = svr.ap.GetActionByHash(h)
+	require.NoError(err)
+
+	// errors
+	res = call("eth_foo", "[]")
+	require.Equal(web3MethodNotFound, res.Error.Code)
+	res = call("eth_getBalance", "[]")
+	require.Equal(web3InvalidParams, res.Error.Code)
+	res = call("eth_getBalance", `["0x123"]`)
+	require.Equal(web3InvalidParams, res.Error.Code)
+	res = call("eth_subscribe", `["newHeads"]`)
+	require.Equal(web3ServerError, res.Error.Code)
+
+	// batch request
+	var batch []*web3Response
+	require.NoError(json.Unmarshal(post(`[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},{"jsonrpc":"2.0","id":2,"method":"net_listening"}]`), &batch))
+	require.Len(batch, 2)
+	require.Equal(`"0x1251"`, string(batch[0].Result))
+	require.Equal("true", string(batch[1].Result))
+	// batch exceeding the limit is rejected
+	ws.batchLimit = 2
+	var batchErr web3Response
+	require.NoError(json.Unmarshal(post(`[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},{"jsonrpc":"2.0","id":2,"method":"eth_chainId"},{"jsonrpc":"2.0","id":3,"method":"eth_chainId"}]`), &batchErr))
+	require.Equal(web3InvalidRequest, batchErr.Error.Code)
+	// every request in batch is charged to the rate limit of client
+	rlCfg := config.Default.API.RateLimit
+	rlCfg.IPAvg = 1
+	rlCfg.IPBurst = 3
+	now := time.Unix(1000, 0)
+	svr.rl = newRateLimiter(rlCfg, svr.rangeCost, ratelimit.WithClock(func() time.Time { return now }))
+	batch = nil
+	require.NoError(json.Unmarshal(post(`[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},{"jsonrpc":"2.0","id":2,"method":"eth_chainId"}]`), &batch))
+	require.Len(batch, 2)
+	require.Nil(batch[0].Error)
+	require.Nil(batch[1].Error)
+	batch = nil
+	require.NoError(json.Unmarshal(post(`[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},{"jsonrpc":"2.0","id":2,"method":"eth_chainId"}]`), &batch))
+	require.Len(batch, 2)
+	require.Nil(batch[0].Error)
+	require.Equal(web3LimitExceeded, batch[1].Error.Code)
+	svr.rl = nil
+
+	// invalid json
+	var parseErr web3Response
+	require.NoError(json.Unmarshal(post(`{"jsonrpc"`), &parseErr))
+	require.Equal(web3ParseError, parseErr.Error.Code)
+}
+
+func TestWeb3SlowClient(t *testing.T) {
+	require := require.New(t)
+
+	hs := httptest.NewServer(NewWebsocketServer(&Server{}, 0))
+	defer hs.Close()
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(hs.URL, "http"), nil)
+	require.NoError(err)
+
+	// the notifications are not written without the writer, so the queue is full after the first one
+	wc := newWsConn(conn, 1)
+	sub := &web3Subscription{id: "0x1", conn: wc}
+	wc.subs[sub.id] = sub
+	blk, err := block.NewTestingBuilder().SetHeight(1).SignAndBuild(identityset.PrivateKey(27))
+	require.NoError(err)
+	require.NoError(sub.Respond(&blk))
+	require.Equal(errSlowClient, sub.Respond(&blk))
+	require.Equal(errSubscriptionClosed, sub.Respond(&blk))
+	require.Empty(wc.subs)
+}
@@ -0,0 +1,353 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+)
+
+type (
+	// web3CallObject is the transaction call object of eth_call and eth_estimateGas
+	web3CallObject struct {
+		From     string `json:"from"`
+		To       string `json:"to"`
+		Gas      string `json:"gas"`
+		GasPrice string `json:"gasPrice"`
+		Value    string `json:"value"`
+		Data     string `json:"data"`
+	}
+
+	// web3Call is the decoded params of eth_call and eth_estimateGas
+	web3Call struct {
+		from, to string
+		value    *big.Int
+		data     []byte
+		tag      string
+		override *evm.StateOverride
+	}
+
+	// web3AccountOverride is the account override of eth_call and eth_estimateGas
+	web3AccountOverride struct {
+		Balance   string            `json:"balance"`
+		Nonce     string            `json:"nonce"`
+		Code      string            `json:"code"`
+		StateDiff map[string]string `json:"stateDiff"`
+	}
+
+	// web3StateOverride is the state override of eth_call and eth_estimateGas keyed by address
+	web3StateOverride map[string]*web3AccountOverride
+
+	// web3FilterObject is the filter object of eth_getLogs and eth_subscribe
+	web3FilterObject struct {
+		FromBlock string           `json:"fromBlock"`
+		ToBlock   string           `json:"toBlock"`
+		Address   web3StringList   `json:"address"`
+		Topics    []web3StringList `json:"topics"`
+		BlockHash string           `json:"blockHash"`
+	}
+
+	// web3StringList is a single string or a list of strings, null is decoded as an empty list
+	web3StringList []string
+
+	web3Log struct {
+		Removed          bool     `json:"removed"`
+		LogIndex         string   `json:"logIndex"`
+		TransactionIndex string   `json:"transactionIndex"`
+		TransactionHash  string   `json:"transactionHash"`
+		BlockHash        string   `json:"blockHash"`
+		BlockNumber      string   `json:"blockNumber"`
+		Address          string   `json:"address"`
+		Data             string   `json:"data"`
+		Topics           []string `json:"topics"`
+	}
+
+	web3Receipt struct {
+		TransactionHash   string     `json:"transactionHash"`
+		TransactionIndex  string     `json:"transactionIndex"`
+		BlockHash         string     `json:"blockHash"`
+		BlockNumber       string     `json:"blockNumber"`
+		From              string     `json:"from"`
+		To                *string    `json:"to"`
+		CumulativeGasUsed string     `json:"cumulativeGasUsed"`
+		GasUsed           string     `json:"gasUsed"`
+		ContractAddress   *string    `json:"contractAddress"`
+		Logs              []*web3Log `json:"logs"`
+		LogsBloom         string     `json:"logsBloom"`
+		Status            string     `json:"status"`
+	}
+
+	web3BlockHeader struct {
+		Number           string `json:"number"`
+		Hash             string `json:"hash"`
+		ParentHash       string `json:"parentHash"`
+		Miner            string `json:"miner"`
+		TransactionsRoot string `json:"transactionsRoot"`
+		StateRoot        string `json:"stateRoot"`
+		ReceiptsRoot     string `json:"receiptsRoot"`
+		GasLimit         string `json:"gasLimit"`
+		GasUsed          string `json:"gasUsed"`
+		Timestamp        string `json:"timestamp"`
+	}
+)
+
+// UnmarshalJSON decodes a string, a list of strings or null
+func (l *web3StringList) UnmarshalJSON(data []byte) error {
+	if string(bytes.TrimSpace(data)) == "null" {
+		*l = nil
+		return nil
+	}
+	var str string
+	if err := json.Unmarshal(data, &str); err == nil {
+		*l = web3StringList{str}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return errors.Wrap(err, "expect a string or a list of strings")
+	}
+	*l = list
+	return nil
+}
+
+// ethAddrToIoAddr converts an Ethereum 0x address into IoTeX io address, io address is accepted as it is
+func ethAddrToIoAddr(addr string) (string, error) {
+	if strings.HasPrefix(addr, address.MainnetPrefix) || strings.HasPrefix(addr, address.TestnetPrefix) {
+		if _, err := address.FromString(addr); err != nil {
+			return "", err
+		}
+		return addr, nil
+	}
+	if !common.IsHexAddress(addr) {
+		return "", errors.Errorf("invalid address %s", addr)
+	}
+	ioAddr, err := address.FromBytes(common.HexToAddress(addr).Bytes())
+	if err != nil {
+		return "", err
+	}
+	return ioAddr.String(), nil
+}
+
+// ioAddrToEthAddr converts an IoTeX io address into Ethereum 0x address in checksum encoding
+func ioAddrToEthAddr(addr string) (string, error) {
+	if addr == "" {
+		return "", nil
+	}
+	ioAddr, err := address.FromString(addr)
+	if err != nil {
+		return "", err
+	}
+	return common.BytesToAddress(ioAddr.Bytes()).Hex(), nil
+}
+
+// parseWeb3Hash decodes a 32-byte hash with or without 0x prefix
+func parseWeb3Hash(h string) (hash.Hash256, error) {
+	return hash.HexStringToHash256(strings.TrimPrefix(strings.TrimPrefix(h, "0x"), "0X"))
+}
+
+func web3Hash(h []byte) string {
+	return "0x" + hex.EncodeToString(h)
+}
+
+// parseWeb3Quantity decodes a hex quantity, empty string is decoded as nil
+func parseWeb3Quantity(q string) (*big.Int, error) {
+	if q == "" {
+		return nil, nil
+	}
+	return hexutil.DecodeBig(q)
+}
+
+// parseWeb3BlockNumber decodes a block number or tag into height, "latest" and "pending" are the tip height
+func parseWeb3BlockNumber(tag string, tipHeight uint64) (uint64, error) {
+	switch tag {
+	case "", "latest", "pending":
+		return tipHeight, nil
+	case "earliest":
+		return 1, nil
+	default:
+		return hexutil.DecodeUint64(tag)
+	}
+}
+
+// execution returns the execution of the call
+func (c *web3Call) execution() *iotextypes.Execution {
+	return &iotextypes.Execution{
+		Amount:   c.value.String(),
+		Contract: c.to,
+		Data:     c.data,
+	}
+}
+
+// toStateOverride converts the web3 state override, nil is returned if there is no override
+func (o web3StateOverride) toStateOverride() (*evm.StateOverride, error) {
+	if len(o) == 0 {
+		return nil, nil
+	}
+	override := &evm.StateOverride{
+		Accounts: make(map[string]*evm.AccountOverride, len(o)),
+	}
+	for addr, account := range o {
+		ioAddr, err := ethAddrToIoAddr(addr)
+		if err != nil {
+			return nil, err
+		}
+		if account == nil {
+			continue
+		}
+		accountOverride := &evm.AccountOverride{}
+		if accountOverride.Balance, err = parseWeb3Quantity(account.Balance); err != nil {
+			return nil, errors.Wrapf(err, "invalid balance of %s", addr)
+		}
+		if account.Nonce != "" {
+			nonce, err := hexutil.DecodeUint64(account.Nonce)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid nonce of %s", addr)
+			}
+			accountOverride.Nonce = &nonce
+		}
+		if account.Code != "" {
+			if accountOverride.Code, err = hexutil.Decode(account.Code); err != nil {
+				return nil, errors.Wrapf(err, "invalid code of %s", addr)
+			}
+		}
+		if len(account.StateDiff) > 0 {
+			accountOverride.Storage = make(map[common.Hash]common.Hash, len(account.StateDiff))
+			for k, v := range account.StateDiff {
+				key, err := decodeStorageSlot(k)
+				if err != nil {
+					return nil, errors.Wrapf(err, "invalid storage key of %s", addr)
+				}
+				value, err := decodeStorageSlot(v)
+				if err != nil {
+					return nil, errors.Wrapf(err, "invalid storage value of %s", addr)
+				}
+				accountOverride.Storage[key] = value
+			}
+		}
+		override.Accounts[ioAddr] = accountOverride
+	}
+	return override, nil
+}
+
+// toLogsFilter converts the web3 filter object into IoTeX logs filter
+func (f *web3FilterObject) toLogsFilter() (*iotexapi.LogsFilter, error) {
+	filter := &iotexapi.LogsFilter{}
+	for _, addr := range f.Address {
+		ioAddr, err := ethAddrToIoAddr(addr)
+		if err != nil {
+			return nil, err
+		}
+		filter.Address = append(filter.Address, ioAddr)
+	}
+	for _, topics := range f.Topics {
+		t := &iotexapi.Topics{}
+		for _, topic := range topics {
+			h, err := parseWeb3Hash(topic)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid topic %s", topic)
+			}
+			t.Topic = append(t.Topic, h[:])
+		}
+		filter.Topics = append(filter.Topics, t)
+	}
+	return filter, nil
+}
+
+// toWeb3Log converts an IoTeX log into web3 log, with the hash and the index of the action in block
+func toWeb3Log(l *iotextypes.Log, blkHash hash.Hash256, actIndex int) (*web3Log, error) {
+	addr, err := ioAddrToEthAddr(l.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+	topics := make([]string, 0, len(l.Topics))
+	for _, topic := range l.Topics {
+		topics = append(topics, web3Hash(topic))
+	}
+	return &web3Log{
+		LogIndex:         hexutil.EncodeUint64(uint64(l.Index)),
+		TransactionIndex: hexutil.EncodeUint64(uint64(actIndex)),
+		TransactionHash:  web3Hash(l.ActHash),
+		BlockHash:        web3Hash(blkHash[:]),
+		BlockNumber:      hexutil.EncodeUint64(l.BlkHeight),
+		Address:          addr,
+		Data:             hexutil.Encode(l.Data),
+		Topics:           topics,
+	}, nil
+}
+
+// actionIndexInBlock returns the index of action in block, or -1 if not found
+func actionIndexInBlock(blk *block.Block, actHash []byte) int {
+	for i, selp := range blk.Actions {
+		h := selp.Hash()
+		if bytes.Equal(h[:], actHash) {
+			return i
+		}
+	}
+	return -1
+}
+
+// web3LogsBloom returns the ethereum bloom of the logs, which is the contract addresses and topics of the logs
+func web3LogsBloom(logs []*action.Log) (string, error) {
+	ethLogs := make([]*types.Log, 0, len(logs))
+	for _, l := range logs {
+		addr, err := address.FromString(l.Address)
+		if err != nil {
+			return "", err
+		}
+		topics := make([]common.Hash, 0, len(l.Topics))
+		for _, t := range l.Topics {
+			topics = append(topics, common.Hash(t))
+		}
+		ethLogs = append(ethLogs, &types.Log{
+			Address: common.BytesToAddress(addr.Bytes()),
+			Topics:  topics,
+		})
+	}
+	return hexutil.Encode(types.BytesToBloom(types.LogsBloom(ethLogs).Bytes()).Bytes()), nil
+}
+
+func toWeb3BlockHeader(blk *block.Block, gasLimit uint64) *web3BlockHeader {
+	var gasUsed uint64
+	for _, r := range blk.Receipts {
+		gasUsed += r.GasConsumed
+	}
+	blkHash := blk.HashBlock()
+	prevHash := blk.PrevHash()
+	txRoot := blk.TxRoot()
+	deltaStateDigest := blk.DeltaStateDigest()
+	receiptRoot := blk.ReceiptRoot()
+	miner, _ := ioAddrToEthAddr(blk.ProducerAddress())
+	return &web3BlockHeader{
+		Number:           hexutil.EncodeUint64(blk.Height()),
+		Hash:             web3Hash(blkHash[:]),
+		ParentHash:       web3Hash(prevHash[:]),
+		Miner:            miner,
+		TransactionsRoot: web3Hash(txRoot[:]),
+		StateRoot:        web3Hash(deltaStateDigest[:]),
+		ReceiptsRoot:     web3Hash(receiptRoot[:]),
+		GasLimit:         hexutil.EncodeUint64(gasLimit),
+		GasUsed:          hexutil.EncodeUint64(gasUsed),
+		Timestamp:        hexutil.EncodeUint64(uint64(blk.Timestamp().Unix())),
+	}
+}
@@ -0,0 +1,444 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+
+	"github.com/iotexproject/iotex-core/api/apipb"
+	"github.com/iotexproject/iotex-core/api/logfilter"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/util/httputil"
+)
+
+const (
+	// wsMaxMessageSize is the max size of a message from websocket client
+	wsMaxMessageSize = 64 * 1024
+	// wsWriteTimeout is the timeout of writing a message to websocket client
+	wsWriteTimeout = 10 * time.Second
+
+	wsTopicBlocks         = "blocks"
+	wsTopicLogs           = "logs"
+	wsTopicPendingActions = "pendingActions"
+)
+
+var (
+	errWebsocketClosed = errors.New("websocket connection closed")
+	errSlowClient      = errors.New("websocket client is too slow to receive messages")
+)
+
+type (
+	// WebsocketServer provides the streaming apis over websocket, which multiplexes the subscriptions of blocks, logs
+	// and pending actions of a client in JSON frames
+	WebsocketServer struct {
+		core      *Server
+		server    http.Server
+		upgrader  websocket.Upgrader
+		queueSize int
+		subID     uint64
+	}
+
+	// wsRequest subscribes to a topic with the params, or unsubscribes the subscription
+	wsRequest struct {
+		ID           json.RawMessage `json:"id"`
+		Type         string          `json:"type"`
+		Topic        string          `json:"topic,omitempty"`
+		Params       json.RawMessage `json:"params,omitempty"`
+		Subscription string          `json:"subscription,omitempty"`
+	}
+
+	wsResponse struct {
+		ID           json.RawMessage `json:"id"`
+		Subscription string          `json:"subscription,omitempty"`
+		Error        string          `json:"error,omitempty"`
+	}
+
+	wsNotification struct {
+		Subscription string          `json:"subscription"`
+		Topic        string          `json:"topic"`
+		Result       json.RawMessage `json:"result"`
+		// Reverted is true if the block or the logs in it are reverted from the tip of chain
+		Reverted bool `json:"reverted,omitempty"`
+	}
+
+	// wsConn is a websocket connection, the messages to client are queued and written by a single writer, so that a
+	// slow client is dropped once its queue is full instead of blocking the listeners
+	wsConn struct {
+		conn      *websocket.Conn
+		queue     chan []byte
+		done      chan struct{}
+		closeOnce sync.Once
+		mutex     sync.Mutex
+		subs      map[string]wsSubscription
+	}
+
+	// wsSubscription is a subscription carried by websocket connection
+	wsSubscription interface {
+		// close stops the subscription, which is removed from the listener once it fails to respond
+		close()
+	}
+
+	// wsStream adapts a subscription to the grpc server stream, so that the responders of grpc streaming apis are
+	// reused to push the messages over websocket
+	wsStream struct {
+		id       string
+		topic    string
+		conn     *wsConn
+		closed   int32
+		reverted bool
+	}
+
+	// wsRevertResponder responds to the reverted block with the responder of new blocks, and marks the notifications
+	// as reverted
+	wsRevertResponder struct {
+		Responder
+		stream *wsStream
+	}
+
+	wsBlockStream struct {
+		*wsStream
+	}
+
+	wsLogStream struct {
+		*wsStream
+	}
+
+	wsPendingActionStream struct {
+		*wsStream
+	}
+)
+
+// NewWebsocketServer creates a new websocket server on the given port
+func NewWebsocketServer(core *Server, port int) *WebsocketServer {
+	ws := &WebsocketServer{
+		core: core,
+		upgrader: websocket.Upgrader{
+			CheckOrigin: func(*http.Request) bool { return true },
+		},
+		queueSize: core.cfg.API.WebsocketQueueSize,
+	}
+	if ws.queueSize <= 0 {
+		ws.queueSize = 1
+	}
+	ws.server = httputil.Server(":"+strconv.Itoa(port), ws)
+	return ws
+}
+
+// Start starts the websocket server
+func (ws *WebsocketServer) Start(_ context.Context) error {
+	ln, err := httputil.LimitListener(ws.server.Addr)
+	if err != nil {
+		return errors.Wrap(err, "websocket server failed to listen")
+	}
+	log.L().Info("Websocket server is listening.", zap.String("addr", ln.Addr().String()))
+	go func() {
+		if err := ws.server.Serve(ln); err != nil {
+			log.L().Info("Websocket server stopped.", zap.Error(err))
+		}
+	}()
+	return nil
+}
+
+// Stop stops the websocket server
+func (ws *WebsocketServer) Stop(ctx context.Context) error {
+	return ws.server.Shutdown(ctx)
+}
+
+// ServeHTTP upgrades the connection to websocket, and handles the subscriptions of the client
+func (ws *WebsocketServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	conn, err := ws.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.L().Warn("Failed to upgrade websocket connection.", zap.Error(err))
+		return
+	}
+	conn.SetReadLimit(wsMaxMessageSize)
+	wc := newWsConn(conn, ws.queueSize)
+	go wc.writeLoop()
+	defer wc.close()
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		out, backfill := ws.handleMessage(wc, data)
+		if err := wc.enqueue(out); err != nil {
+			return
+		}
+		if backfill != nil {
+			// replay the historical blocks after the response, so that the client knows the subscription id
+			go func() {
+				if err := backfill(); err != nil {
+					log.L().Info("Failed to replay blocks over websocket.", zap.Error(err))
+				}
+			}()
+		}
+	}
+}
+
+// handleMessage handles the request, and returns the response with the function to replay the historical blocks of
+// the subscription if any
+func (ws *WebsocketServer) handleMessage(wc *wsConn, data []byte) ([]byte, func() error) {
+	var (
+		req      wsRequest
+		res      wsResponse
+		backfill func() error
+		err      error
+	)
+	if err = json.Unmarshal(data, &req); err == nil {
+		res.ID = req.ID
+		switch req.Type {
+		case "subscribe":
+			res.Subscription, backfill, err = ws.subscribe(wc, req.Topic, req.Params)
+		case "unsubscribe":
+			res.Subscription, err = req.Subscription, wc.unsubscribe(req.Subscription)
+		default:
+			err = errors.Errorf("unsupported request type %s", req.Type)
+		}
+	}
+	if err != nil {
+		res.Error = err.Error()
+	}
+	out, err := json.Marshal(&res)
+	if err != nil {
+		log.L().Panic("Failed to marshal websocket response.", zap.Error(err))
+	}
+	return out, backfill
+}
+
+// subscribe adds the responder of the topic to the listeners, the params are the same as the grpc streaming request
+// in JSON. The blocks and logs from the start height are replayed by the returned function
+func (ws *WebsocketServer) subscribe(wc *wsConn, topic string, params json.RawMessage) (string, func() error, error) {
+	stream := &wsStream{
+		id:    strconv.FormatUint(atomic.AddUint64(&ws.subID, 1), 10),
+		topic: topic,
+		conn:  wc,
+	}
+	errChan := make(chan error, 1)
+	var (
+		backfill func() error
+		err      error
+	)
+	switch topic {
+	case wsTopicBlocks:
+		var in apipb.StreamBlocksFromRequest
+		if err := unmarshalWsParams(params, &in); err != nil {
+			return "", nil, err
+		}
+		r := &wsRevertResponder{NewBlockListener(&wsBlockStream{stream}, errChan), stream}
+		backfill, err = ws.core.addBlockResponder(r, in.GetStartHeight(), ws.core.allHeights)
+		if err != nil {
+			return "", nil, err
+		}
+	case wsTopicLogs:
+		var in apipb.StreamLogsFromRequest
+		if err := unmarshalWsParams(params, &in); err != nil {
+			return "", nil, err
+		}
+		if in.GetFilter() == nil {
+			return "", nil, errors.New("empty filter")
+		}
+		filter := logfilter.NewLogFilter(in.GetFilter(), &wsLogStream{stream}, errChan)
+		backfill, err = ws.core.addBlockResponder(&wsRevertResponder{filter, stream}, in.GetStartHeight(), ws.core.logHeights(filter))
+		if err != nil {
+			return "", nil, err
+		}
+	case wsTopicPendingActions:
+		var in apipb.StreamPendingActionsRequest
+		if err := unmarshalWsParams(params, &in); err != nil {
+			return "", nil, err
+		}
+		for _, addr := range in.GetAddresses() {
+			if _, err := address.FromString(addr); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := ws.core.actionListener.AddResponder(NewPendingActionListener(in.GetAddresses(), &wsPendingActionStream{stream}, errChan)); err != nil {
+			return "", nil, err
+		}
+	default:
+		return "", nil, errors.Errorf("unsupported topic %s", topic)
+	}
+	wc.mutex.Lock()
+	wc.subs[stream.id] = stream
+	wc.mutex.Unlock()
+	go func() {
+		// the responder exits when the listener stops or the replay fails, otherwise it is removed from the listener
+		// once it fails to respond
+		if err := <-errChan; err == nil {
+			wc.close()
+		}
+	}()
+	return stream.id, backfill, nil
+}
+
+func unmarshalWsParams(params json.RawMessage, m proto.Message) error {
+	if len(params) == 0 {
+		return nil
+	}
+	return protojson.Unmarshal(params, m)
+}
+
+func newWsConn(conn *websocket.Conn, queueSize int) *wsConn {
+	return &wsConn{
+		conn:  conn,
+		queue: make(chan []byte, queueSize),
+		done:  make(chan struct{}),
+		subs:  make(map[string]wsSubscription),
+	}
+}
+
+// enqueue queues the message to be written, and drops the client if its queue is full
+func (wc *wsConn) enqueue(data []byte) error {
+	select {
+	case <-wc.done:
+		return errWebsocketClosed
+	default:
+	}
+	select {
+	case wc.queue <- data:
+		return nil
+	default:
+		log.L().Info("Drop slow websocket client.", zap.String("remote", wc.conn.RemoteAddr().String()))
+		wc.close()
+		return errSlowClient
+	}
+}
+
+func (wc *wsConn) writeLoop() {
+	for {
+		select {
+		case <-wc.done:
+			return
+		case data := <-wc.queue:
+			if err := wc.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout)); err != nil {
+				wc.close()
+				return
+			}
+			if err := wc.conn.WriteMessage(websocket.TextMessage, data); err != nil {
+				wc.close()
+				return
+			}
+		}
+	}
+}
+
+func (wc *wsConn) unsubscribe(id string) error {
+	wc.mutex.Lock()
+	defer wc.mutex.Unlock()
+	sub, ok := wc.subs[id]
+	if !ok {
+		return errors.Errorf("subscription %s not found", id)
+	}
+	sub.close()
+	delete(wc.subs, id)
+	return nil
+}
+
+func (wc *wsConn) close() {
+	wc.closeOnce.Do(func() {
+		close(wc.done)
+		wc.mutex.Lock()
+		for id, sub := range wc.subs {
+			sub.close()
+			delete(wc.subs, id)
+		}
+		wc.mutex.Unlock()
+		if err := wc.conn.Close(); err != nil {
+			log.L().Debug("Failed to close websocket connection.", zap.Error(err))
+		}
+	})
+}
+
+func (s *wsStream) close() {
+	atomic.StoreInt32(&s.closed, 1)
+}
+
+// send marshals the message in JSON, and queues it as a notification of the subscription
+func (s *wsStream) send(m proto.Message) error {
+	if atomic.LoadInt32(&s.closed) == 1 {
+		return errSubscriptionClosed
+	}
+	result, err := protojson.Marshal(m)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(&wsNotification{
+		Subscription: s.id,
+		Topic:        s.topic,
+		Result:       result,
+		Reverted:     s.reverted,
+	})
+	if err != nil {
+		return err
+	}
+	return s.conn.enqueue(data)
+}
+
+// RespondRevert responds to the reverted block as a new block, with the notifications marked as reverted. The
+// responder is called by listener or replay one at a time, so the flag is not guarded
+func (r *wsRevertResponder) RespondRevert(blk *block.Block) error {
+	r.stream.reverted = true
+	defer func() {
+		r.stream.reverted = false
+	}()
+	return r.Respond(blk)
+}
+
+func (s *wsStream) SetHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *wsStream) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *wsStream) SetTrailer(metadata.MD) {}
+
+func (s *wsStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *wsStream) SendMsg(m interface{}) error {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return errors.Errorf("unexpected message type %T", m)
+	}
+	return s.send(msg)
+}
+
+func (s *wsStream) RecvMsg(interface{}) error {
+	return errors.New("websocket stream does not receive messages")
+}
+
+func (s *wsBlockStream) Send(res *iotexapi.StreamBlocksResponse) error {
+	return s.send(res)
+}
+
+func (s *wsLogStream) Send(res *iotexapi.StreamLogsResponse) error {
+	return s.send(res)
+}
+
+func (s *wsPendingActionStream) Send(res *apipb.StreamPendingActionsResponse) error {
+	return s.send(res)
+}
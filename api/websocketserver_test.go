@@ -0,0 +1,190 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+
+	"github.com/iotexproject/iotex-core/actpool"
+	"github.com/iotexproject/iotex-core/api/apipb"
+	"github.com/iotexproject/iotex-core/api/logfilter"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestWebsocketServer(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig(t)
+
+	svr, bfIndexFile, err := createServer(cfg, false)
+	require.NoError(err)
+	defer func() {
+		testutil.CleanupPath(t, bfIndexFile)
+	}()
+	svr.chainListener = NewChainListener()
+	svr.actionListener = NewActionListener()
+	hs := httptest.NewServer(NewWebsocketServer(svr, 0))
+	defer hs.Close()
+	url := "ws" + strings.TrimPrefix(hs.URL, "http")
+
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(err)
+	defer conn.Close()
+	require.NoError(conn.SetReadDeadline(time.Now().Add(10 * time.Second)))
+	call := func(req string) *wsResponse {
+		require.NoError(conn.WriteMessage(websocket.TextMessage, []byte(req)))
+		var res wsResponse
+		require.NoError(conn.ReadJSON(&res))
+		return &res
+	}
+	sender := identityset.Address(28).String()
+	res := call(`{"id":1,"type":"subscribe","topic":"blocks"}`)
+	require.Empty(res.Error)
+	require.Equal("1", string(res.ID))
+	blocksSub := res.Subscription
+	res = call(`{"id":2,"type":"subscribe","topic":"logs","params":{"filter":{}}}`)
+	require.Empty(res.Error)
+	logsSub := res.Subscription
+	res = call(`{"id":3,"type":"subscribe","topic":"pendingActions","params":{"addresses":["` + sender + `"]}}`)
+	require.Empty(res.Error)
+	pendingSub := res.Subscription
+
+	// invalid requests
+	for _, req := range []string{
+		`{"id":4,"type":"subscribe","topic":"unknown"}`,
+		`{"id":5,"type":"subscribe","topic":"logs"}`,
+		`{"id":6,"type":"subscribe","topic":"pendingActions","params":{"addresses":["invalid"]}}`,
+		`{"id":7,"type":"unsubscribe","subscription":"unknown"}`,
+		`{"id":8,"type":"unknown"}`,
+	} {
+		require.NotEmpty(call(req).Error, req)
+	}
+	require.NotEmpty(call(`invalid`).Error)
+
+	// a block with logs
+	var blk *block.Block
+	for h := uint64(1); h <= svr.bc.TipHeight(); h++ {
+		blk, err = svr.dao.GetBlockByHeight(h)
+		require.NoError(err)
+		blk.Receipts, err = svr.dao.GetReceipts(h)
+		require.NoError(err)
+		if len(logfilter.NewLogFilter(&iotexapi.LogsFilter{}, nil, nil).MatchLogs(blk.Receipts)) > 0 {
+			break
+		}
+	}
+	numLogs := len(logfilter.NewLogFilter(&iotexapi.LogsFilter{}, nil, nil).MatchLogs(blk.Receipts))
+	require.NotZero(numLogs)
+
+	read := func() *wsNotification {
+		var n wsNotification
+		require.NoError(conn.ReadJSON(&n))
+		return &n
+	}
+	require.NoError(svr.chainListener.ReceiveBlock(blk))
+	var blocks, logs int
+	for i := 0; i < 1+numLogs; i++ {
+		n := read()
+		switch n.Topic {
+		case wsTopicBlocks:
+			require.Equal(blocksSub, n.Subscription)
+			var res iotexapi.StreamBlocksResponse
+			require.NoError(protojson.Unmarshal(n.Result, &res))
+			require.Equal(blk.Height(), res.GetBlock().GetBlock().GetHeader().GetCore().GetHeight())
+			blocks++
+		case wsTopicLogs:
+			require.Equal(logsSub, n.Subscription)
+			var res iotexapi.StreamLogsResponse
+			require.NoError(protojson.Unmarshal(n.Result, &res))
+			require.Equal(blk.Height(), res.GetLog().GetBlkHeight())
+			logs++
+		}
+	}
+	require.Equal(1, blocks)
+	require.Equal(numLogs, logs)
+
+	tsf, err := testutil.SignedTransfer(identityset.Address(29).String(), identityset.PrivateKey(28), 1, big.NewInt(10), nil, 10000, big.NewInt(0))
+	require.NoError(err)
+	require.NoError(svr.actionListener.ReceiveActionEvent(&actpool.ActionEvent{
+		Type:   actpool.ActionAdded,
+		Action: tsf,
+		Hash:   tsf.Hash(),
+		Sender: sender,
+	}))
+	n := read()
+	require.Equal(pendingSub, n.Subscription)
+	require.Equal(wsTopicPendingActions, n.Topic)
+	var pending apipb.StreamPendingActionsResponse
+	require.NoError(protojson.Unmarshal(n.Result, &pending))
+	require.Equal(apipb.PendingActionEventType_ADDED, pending.GetEvent().GetType())
+	require.Equal(sender, pending.GetEvent().GetSender())
+
+	// the unsubscribed responder is removed from listener on next block
+	res = call(`{"id":9,"type":"unsubscribe","subscription":"` + blocksSub + `"}`)
+	require.Empty(res.Error)
+	require.Equal(blocksSub, res.Subscription)
+	listener := svr.chainListener.(*chainListener)
+	require.Equal(2, listener.streamMap.Len())
+	require.NoError(svr.chainListener.ReceiveBlock(blk))
+	require.Equal(1, listener.streamMap.Len())
+	for i := 0; i < numLogs; i++ {
+		require.Equal(wsTopicLogs, read().Topic)
+	}
+
+	// the logs from the start height are replayed after the response
+	allLogs, err := svr.getLogsInRange(logfilter.NewLogFilter(&iotexapi.LogsFilter{}, nil, nil), 1, svr.bc.TipHeight(), 1000)
+	require.NoError(err)
+	require.NotEmpty(allLogs)
+	res = call(`{"id":10,"type":"subscribe","topic":"logs","params":{"filter":{},"startHeight":1}}`)
+	require.Empty(res.Error)
+	for _, l := range allLogs {
+		n := read()
+		require.Equal(res.Subscription, n.Subscription)
+		var logRes iotexapi.StreamLogsResponse
+		require.NoError(protojson.Unmarshal(n.Result, &logRes))
+		require.Equal(l.BlkHeight, logRes.GetLog().GetBlkHeight())
+		require.Equal(l.Index, logRes.GetLog().GetIndex())
+	}
+
+	// the logs in reverted block are sent to both logs subscriptions as reverted
+	require.NoError(svr.chainListener.RevertBlock(blk))
+	for i := 0; i < 2*numLogs; i++ {
+		n := read()
+		require.Equal(wsTopicLogs, n.Topic)
+		require.True(n.Reverted)
+	}
+}
+
+func TestWebsocketSlowClient(t *testing.T) {
+	require := require.New(t)
+
+	hs := httptest.NewServer(NewWebsocketServer(&Server{}, 0))
+	defer hs.Close()
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(hs.URL, "http"), nil)
+	require.NoError(err)
+
+	// the messages are not written without the writer, so the queue is full after the first one
+	wc := newWsConn(conn, 1)
+	stream := &wsStream{id: "1", topic: wsTopicBlocks, conn: wc}
+	wc.subs[stream.id] = stream
+	blocks := &wsBlockStream{stream}
+	require.NoError(blocks.Send(&iotexapi.StreamBlocksResponse{}))
+	require.Equal(errSlowClient, blocks.Send(&iotexapi.StreamBlocksResponse{}))
+	require.Equal(errSubscriptionClosed, blocks.Send(&iotexapi.StreamBlocksResponse{}))
+	require.Equal(errWebsocketClosed, wc.enqueue([]byte("{}")))
+	require.Empty(wc.subs)
+}
@@ -116,6 +116,25 @@ func (b *Block) VerifyReceiptRoot(root hash.Hash256) error {
 	return nil
 }
 
+// ActionProof returns the merkle proof of the action at index against the transaction root
+func (b *Block) ActionProof(index int) ([]hash.Hash256, error) {
+	h := make([]hash.Hash256, 0, len(b.Actions))
+	for _, act := range b.Actions {
+		h = append(h, act.Hash())
+	}
+	return merkleProof(h, index)
+}
+
+// ReceiptProof returns the merkle proof of the receipt at index against the receipt root, the receipts of the block
+// should have been loaded
+func (b *Block) ReceiptProof(index int) ([]hash.Hash256, error) {
+	h := make([]hash.Hash256, 0, len(b.Receipts))
+	for _, receipt := range b.Receipts {
+		h = append(h, receipt.Hash())
+	}
+	return merkleProof(h, index)
+}
+
 // RunnableActions abstructs RunnableActions from a Block.
 func (b *Block) RunnableActions() RunnableActions {
 	return RunnableActions{actions: b.Actions, txHash: b.txRoot}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/crypto"
 	"github.com/iotexproject/iotex-core/pkg/compress"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/pkg/unit"
@@ -67,6 +68,46 @@ func TestMerkle(t *testing.T) {
 	t.Log("Merkle root match pass\n")
 }
 
+func TestActionAndReceiptProof(t *testing.T) {
+	require := require.New(t)
+
+	producerPriKey := identityset.PrivateKey(27)
+	var (
+		acts     []action.SealedEnvelope
+		receipts []*action.Receipt
+		leaves   []hash.Hash256
+	)
+	for i := 0; i < 5; i++ {
+		selp, err := testutil.SignedTransfer(identityset.Address(28+i).String(), producerPriKey, uint64(i+1), big.NewInt(10), nil, 100, big.NewInt(0))
+		require.NoError(err)
+		acts = append(acts, selp)
+		receipt := &action.Receipt{Status: uint64(iotextypes.ReceiptStatus_Success), BlockHeight: 1, ActionHash: selp.Hash(), GasConsumed: uint64(i)}
+		receipts = append(receipts, receipt)
+		leaves = append(leaves, receipt.Hash())
+	}
+	blk := NewBlockDeprecated(0, 1, hash.ZeroHash256, testutil.TimestampNow(), identityset.PrivateKey(27).PublicKey(), acts)
+	blk.Receipts = receipts
+	blk.Header.receiptRoot = crypto.NewMerkleTree(leaves).HashTree()
+
+	for i := range acts {
+		proof, err := blk.ActionProof(i)
+		require.NoError(err)
+		require.True(blk.VerifyActionProof(acts[i].Hash(), i, proof))
+		require.False(blk.VerifyActionProof(acts[(i+1)%len(acts)].Hash(), i, proof))
+		require.False(blk.VerifyReceiptProof(acts[i].Hash(), i, proof))
+
+		proof, err = blk.ReceiptProof(i)
+		require.NoError(err)
+		require.True(blk.VerifyReceiptProof(receipts[i].Hash(), i, proof))
+		require.False(blk.VerifyReceiptProof(receipts[i].Hash(), (i+1)%len(acts), proof))
+	}
+	_, err := blk.ActionProof(len(acts) + 1)
+	require.Error(err)
+	blk.Receipts = nil
+	_, err = blk.ReceiptProof(0)
+	require.Error(err)
+}
+
 func TestConvertFromBlockPb(t *testing.T) {
 	blk := Block{}
 	senderPubKey := identityset.PrivateKey(27).PublicKey()
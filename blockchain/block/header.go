@@ -18,6 +18,7 @@ import (
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 	"go.uber.org/zap"
 
+	cp "github.com/iotexproject/iotex-core/crypto"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
 )
@@ -67,6 +68,18 @@ func (h *Header) HashBlock() hash.Hash256 { return h.HashHeader() }
 // LogsBloomfilter return the bloom filter for all contract log events
 func (h *Header) LogsBloomfilter() bloom.BloomFilter { return h.logsBloom }
 
+// VerifyActionProof verifies the action of the hash is at index of the block with the merkle proof against the
+// transaction root
+func (h *Header) VerifyActionProof(actHash hash.Hash256, index int, proof []hash.Hash256) bool {
+	return cp.VerifyMerkleProof(h.txRoot, actHash, index, proof)
+}
+
+// VerifyReceiptProof verifies the receipt of the hash is at index of the block with the merkle proof against the
+// receipt root
+func (h *Header) VerifyReceiptProof(receiptHash hash.Hash256, index int, proof []hash.Hash256) bool {
+	return cp.VerifyMerkleProof(h.receiptRoot, receiptHash, index, proof)
+}
+
 // BlockHeaderProto returns BlockHeader proto.
 func (h *Header) BlockHeaderProto() *iotextypes.BlockHeader {
 	return &iotextypes.BlockHeader{
@@ -28,6 +28,13 @@ func calculateTxRoot(acts []action.SealedEnvelope) hash.Hash256 {
 	return crypto.NewMerkleTree(h).HashTree()
 }
 
+func merkleProof(leaves []hash.Hash256, index int) ([]hash.Hash256, error) {
+	if len(leaves) == 0 {
+		return nil, errors.New("cannot generate merkle proof of empty leaves")
+	}
+	return crypto.NewMerkleTree(leaves).Proof(index)
+}
+
 // calculateTransferAmount returns the calculated transfer amount
 func calculateTransferAmount(acts []action.SealedEnvelope) *big.Int {
 	transferAmount := big.NewInt(0)
@@ -87,6 +87,10 @@ type (
 		CommitBlock(blk *block.Block) error
 		// ValidateBlock validates a new block before adding it to the blockchain
 		ValidateBlock(blk *block.Block) error
+		// DeleteBlockToTarget deletes the blocks from the tip down to the target height, and notifies the subscribers of
+		// every reverted block. The state is not reverted, which should be rebuilt by the caller, and the actions of the
+		// reverted blocks are not put back into the actpool
+		DeleteBlockToTarget(targetHeight uint64) error
 
 		// AddSubscriber make you listen to every single produced block
 		AddSubscriber(BlockCreationSubscriber) error
@@ -402,6 +406,34 @@ func (bc *blockchain) CommitBlock(blk *block.Block) error {
 	return bc.commitBlock(blk)
 }
 
+// DeleteBlockToTarget deletes the blocks above the target height one by one from the tip
+func (bc *blockchain) DeleteBlockToTarget(targetHeight uint64) error {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+
+	tipHeight, err := bc.dao.Height()
+	if err != nil {
+		return err
+	}
+	for ; tipHeight > targetHeight; tipHeight-- {
+		blk, err := bc.dao.GetBlockByHeight(tipHeight)
+		if err != nil {
+			return errors.Wrap(err, "failed to get tip block")
+		}
+		// the receipts are kept in the reverted block, so that subscribers can undo the logs
+		if blk.Receipts, err = bc.dao.GetReceipts(tipHeight); err != nil {
+			return errors.Wrap(err, "failed to get receipts of tip block")
+		}
+		if err := bc.dao.DeleteBlockToTarget(tipHeight - 1); err != nil {
+			return err
+		}
+		blkHash := blk.HashBlock()
+		blk.HeaderLogger(log.L()).Info("Reverted a block.", log.Hex("tipHash", blkHash[:]))
+		bc.emitRevertToSubscribers(blk)
+	}
+	return nil
+}
+
 func (bc *blockchain) AddSubscriber(s BlockCreationSubscriber) error {
 	bc.mu.Lock()
 	defer bc.mu.Unlock()
@@ -487,3 +519,10 @@ func (bc *blockchain) emitToSubscribers(blk *block.Block) {
 	}
 	bc.pubSubManager.SendBlockToSubscribers(blk)
 }
+
+func (bc *blockchain) emitRevertToSubscribers(blk *block.Block) {
+	if bc.pubSubManager == nil {
+		return
+	}
+	bc.pubSubManager.SendRevertedBlockToSubscribers(blk)
+}
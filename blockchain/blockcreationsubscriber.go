@@ -14,3 +14,10 @@ import (
 type BlockCreationSubscriber interface {
 	ReceiveBlock(*block.Block) error
 }
+
+// BlockRevertSubscriber is a block creation subscriber which will also get notified when a block is reverted from the
+// tip of chain, so that it can undo the data of the block
+type BlockRevertSubscriber interface {
+	BlockCreationSubscriber
+	RevertBlock(*block.Block) error
+}
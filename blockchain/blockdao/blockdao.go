@@ -8,6 +8,7 @@ package blockdao
 
 import (
 	"context"
+	"math/big"
 	"sync/atomic"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/blockchain/filedao"
 	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
 	"github.com/iotexproject/iotex-core/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/pkg/prometheustimer"
@@ -59,6 +61,13 @@ type (
 		DeleteTipBlock(blk *block.Block) error
 	}
 
+	// TransactionLogIndexer defines a block indexer which may index the transaction logs in the receipts
+	TransactionLogIndexer interface {
+		BlockIndexer
+		// RequireTransactionLog returns true if the indexer requires the block store to contain transaction logs
+		RequireTransactionLog() bool
+	}
+
 	blockDAO struct {
 		blockStore   filedao.FileDAO
 		indexers     []BlockIndexer
@@ -95,6 +104,9 @@ func (dao *blockDAO) Start(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "failed to start child services")
 	}
+	if err := dao.checkTransactionLog(); err != nil {
+		return err
+	}
 
 	tipHeight, err := dao.blockStore.Height()
 	if err != nil {
@@ -129,6 +141,20 @@ func (dao *blockDAO) fillWithBlockInfoAsTip(ctx context.Context, height uint64)
 	return protocol.WithBlockchainCtx(ctx, bcCtx), nil
 }
 
+// checkTransactionLog rejects the indexers requiring transaction logs if the block store does not contain them,
+// otherwise the indexers would silently miss the transaction logs
+func (dao *blockDAO) checkTransactionLog() error {
+	if dao.blockStore.ContainsTransactionLog() {
+		return nil
+	}
+	for _, indexer := range dao.indexers {
+		if txLogIndexer, ok := indexer.(TransactionLogIndexer); ok && txLogIndexer.RequireTransactionLog() {
+			return errors.Errorf("indexer %T requires transaction logs, which are not contained in block store", indexer)
+		}
+	}
+	return nil
+}
+
 func (dao *blockDAO) checkIndexers(ctx context.Context) error {
 	bcCtx, ok := protocol.GetBlockchainCtx(ctx)
 	if !ok {
@@ -153,6 +179,10 @@ func (dao *blockDAO) checkIndexers(ctx context.Context) error {
 				if err != nil {
 					return err
 				}
+				// transaction logs are not stored in the receipts
+				if err := dao.fillTransactionLogs(blk); err != nil {
+					return err
+				}
 			}
 			producer, err := address.FromBytes(blk.PublicKey().Hash())
 			if err != nil {
@@ -310,6 +340,45 @@ func (dao *blockDAO) TransactionLogs(height uint64) (*iotextypes.TransactionLogs
 	return dao.blockStore.TransactionLogs(height)
 }
 
+// fillTransactionLogs adds the transaction logs stored in the block store into the receipts of the block
+func (dao *blockDAO) fillTransactionLogs(blk *block.Block) error {
+	if !dao.blockStore.ContainsTransactionLog() {
+		return nil
+	}
+	sysLog, err := dao.blockStore.TransactionLogs(blk.Height())
+	switch errors.Cause(err) {
+	case nil:
+	case db.ErrNotExist:
+		// no transaction log in the block
+		return nil
+	default:
+		return err
+	}
+	receipts := make(map[hash.Hash256]*action.Receipt, len(blk.Receipts))
+	for _, r := range blk.Receipts {
+		receipts[r.ActionHash] = r
+	}
+	for _, actLog := range sysLog.GetLogs() {
+		r, ok := receipts[hash.BytesToHash256(actLog.GetActionHash())]
+		if !ok || len(r.TransactionLogs()) > 0 {
+			continue
+		}
+		for _, tx := range actLog.GetTransactions() {
+			amount, ok := new(big.Int).SetString(tx.GetAmount(), 10)
+			if !ok {
+				return errors.Errorf("invalid amount %s in transaction log of block %d", tx.GetAmount(), blk.Height())
+			}
+			r.AddTransactionLogs(&action.TransactionLog{
+				Type:      tx.GetType(),
+				Amount:    amount,
+				Sender:    tx.GetSender(),
+				Recipient: tx.GetRecipient(),
+			})
+		}
+	}
+	return nil
+}
+
 func (dao *blockDAO) PutBlock(ctx context.Context, blk *block.Block) error {
 	timer := dao.timerFactory.NewTimer("put_block")
 	defer timer.End()
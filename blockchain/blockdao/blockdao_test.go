@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
@@ -408,6 +409,53 @@ func TestBlockDAO(t *testing.T) {
 	}
 }
 
+func TestBlockDAORawTx(t *testing.T) {
+	require := require.New(t)
+
+	testPath, err := testutil.PathOfTempFile("test-raw-tx")
+	require.NoError(err)
+	testutil.CleanupPath(t, testPath)
+	defer func() {
+		testutil.CleanupPath(t, testPath)
+	}()
+	cfg := config.Default.DB
+	cfg.DbPath = testPath
+	dao, err := createTestBlockDAO(false, false, "", cfg)
+	require.NoError(err)
+	ctx := protocol.WithBlockchainCtx(
+		context.Background(),
+		protocol.BlockchainCtx{
+			Genesis: config.Default.Genesis,
+		},
+	)
+	require.NoError(dao.Start(ctx))
+	defer func() {
+		require.NoError(dao.Stop(ctx))
+	}()
+
+	raw, err := testutil.SignedRawTx(identityset.Address(28).String(), identityset.PrivateKey(27), 1, big.NewInt(10), testutil.TestGasLimit, big.NewInt(0), nil, config.Default.Genesis.EVMNetworkID)
+	require.NoError(err)
+	selp, err := action.DecodeRawTx(raw, config.Default.Genesis.EVMNetworkID)
+	require.NoError(err)
+	blk, err := block.NewTestingBuilder().
+		SetHeight(1).
+		SetTimeStamp(time.Now()).
+		AddActions(selp).
+		SignAndBuild(identityset.PrivateKey(0))
+	require.NoError(err)
+	require.NoError(dao.PutBlock(ctx, &blk))
+
+	h := selp.Hash()
+	act, err := dao.GetActionByActionHash(h, 1)
+	require.NoError(err)
+	require.Equal(action.EthereumRLP, act.Encoding())
+	require.Equal(h, act.Hash())
+	require.NoError(action.Verify(act))
+	stored, err := dao.GetBlockByHeight(1)
+	require.NoError(err)
+	require.Equal(blk.HashBlock(), stored.HashBlock())
+}
+
 func createTestBlockDAO(inMemory, legacy bool, compressBlock string, cfg config.DB) (BlockDAO, error) {
 	if inMemory {
 		return NewBlockDAOInMemForTest(nil), nil
@@ -485,3 +533,102 @@ func BenchmarkBlockCache(b *testing.B) {
 		test(0, b)
 	})
 }
+
+func TestFillTransactionLogs(t *testing.T) {
+	require := require.New(t)
+
+	ctx := protocol.WithBlockchainCtx(
+		context.Background(),
+		protocol.BlockchainCtx{
+			Genesis: config.Default.Genesis,
+		},
+	)
+	dao := NewBlockDAOInMemForTest(nil)
+	require.NoError(dao.Start(ctx))
+	defer func() {
+		require.NoError(dao.Stop(ctx))
+	}()
+
+	blk := getTestBlocks(t)[0]
+	h1, h2 := blk.Actions[0].Hash(), blk.Actions[1].Hash()
+	txLog := &action.TransactionLog{
+		Type:      iotextypes.TransactionLogType_NATIVE_TRANSFER,
+		Amount:    big.NewInt(100),
+		Sender:    identityset.Address(28).String(),
+		Recipient: identityset.Address(29).String(),
+	}
+	blk.Receipts = []*action.Receipt{
+		(&action.Receipt{BlockHeight: 1, ActionHash: h1}).AddTransactionLogs(txLog),
+		{BlockHeight: 1, ActionHash: h2},
+	}
+	require.NoError(dao.PutBlock(ctx, blk))
+
+	stored, err := dao.GetBlockByHeight(1)
+	require.NoError(err)
+	stored.Receipts, err = dao.GetReceipts(1)
+	require.NoError(err)
+	require.Len(stored.Receipts, 2)
+	require.Empty(stored.Receipts[0].TransactionLogs())
+	require.NoError(dao.(*blockDAO).fillTransactionLogs(stored))
+	require.Equal([]*action.TransactionLog{txLog}, stored.Receipts[0].TransactionLogs())
+	require.Empty(stored.Receipts[1].TransactionLogs())
+}
+
+type (
+	noTxLogFileDAO struct {
+		filedao.FileDAO
+	}
+
+	txLogIndexer struct {
+		BlockIndexer
+		require bool
+	}
+)
+
+func (fd *noTxLogFileDAO) ContainsTransactionLog() bool { return false }
+
+func (x *txLogIndexer) Start(context.Context) error { return nil }
+
+func (x *txLogIndexer) Stop(context.Context) error { return nil }
+
+func (x *txLogIndexer) RequireTransactionLog() bool { return x.require }
+
+func TestCheckTransactionLog(t *testing.T) {
+	require := require.New(t)
+
+	ctx := protocol.WithBlockchainCtx(
+		context.Background(),
+		protocol.BlockchainCtx{
+			Genesis: config.Default.Genesis,
+		},
+	)
+	for _, c := range []struct {
+		containsTxLog, requireTxLog, err bool
+	}{
+		{true, true, false},
+		{true, false, false},
+		{false, false, false},
+		{false, true, true},
+	} {
+		blkStore, err := filedao.NewFileDAOInMemForTest()
+		require.NoError(err)
+		if !c.containsTxLog {
+			blkStore = &noTxLogFileDAO{blkStore}
+		}
+		dao := createBlockDAO(blkStore, []BlockIndexer{&txLogIndexer{require: c.requireTxLog}}, config.DB{})
+		require.NotNil(dao)
+		err = dao.(*blockDAO).checkTransactionLog()
+		if c.err {
+			require.Error(err)
+		} else {
+			require.NoError(err)
+		}
+	}
+
+	// block DAO fails to start if the transaction logs required by the indexer are not contained in block store
+	blkStore, err := filedao.NewFileDAOInMemForTest()
+	require.NoError(err)
+	dao := createBlockDAO(&noTxLogFileDAO{blkStore}, []BlockIndexer{&txLogIndexer{require: true}}, config.DB{})
+	require.Error(dao.Start(ctx))
+	require.NoError(dao.Stop(ctx))
+}
@@ -59,6 +59,8 @@ func defaultConfig() Genesis {
 			FairbankBlockHeight:     5165641,
 			GreenlandBlockHeight:    6544441,
 			HawaiiBlockHeight:       11073241,
+			IcelandBlockHeight:      12289321,
+			EVMNetworkID:            4689,
 		},
 		Account: Account{
 			InitBalanceMap: make(map[string]string),
@@ -172,6 +174,10 @@ type (
 		GreenlandBlockHeight uint64 `yaml:"greenlandHeight"`
 		// HawaiiBlockHeight is the start height to fix GetBlockHash in EVM
 		HawaiiBlockHeight uint64 `yaml:"hawaiiHeight"`
+		// IcelandBlockHeight is the start height to accept RLP-encoded Ethereum transaction
+		IcelandBlockHeight uint64 `yaml:"icelandHeight"`
+		// EVMNetworkID is the chain ID of EIP-155 signer, which Ethereum transactions are signed for
+		EVMNetworkID uint32 `yaml:"evmNetworkID"`
 	}
 	// Account contains the configs for account protocol
 	Account struct {
@@ -50,6 +50,7 @@ import (
 	"github.com/iotexproject/iotex-core/state/factory"
 	"github.com/iotexproject/iotex-core/test/identityset"
 	"github.com/iotexproject/iotex-core/test/mock/mock_blockcreationsubscriber"
+	"github.com/iotexproject/iotex-core/test/mock/mock_blockdao"
 	"github.com/iotexproject/iotex-core/testutil"
 )
 
@@ -1619,6 +1620,48 @@ func TestBlockchain_RemoveSubscriber(t *testing.T) {
 	req.EqualError(bc.RemoveSubscriber(nil), "cannot find subscription")
 }
 
+func TestBlockchain_DeleteBlockToTarget(t *testing.T) {
+	req := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dao := mock_blockdao.NewMockBlockDAO(ctrl)
+	bc := blockchain.NewBlockchain(config.Default, dao, nil)
+	// only the revert subscriber is notified of reverted blocks
+	reverted := make(chan uint64, 2)
+	mr := mock_blockcreationsubscriber.NewMockBlockRevertSubscriber(ctrl)
+	mr.EXPECT().RevertBlock(gomock.Any()).DoAndReturn(func(blk *block.Block) error {
+		reverted <- blk.Height()
+		return nil
+	}).Times(2)
+	mb := mock_blockcreationsubscriber.NewMockBlockCreationSubscriber(ctrl)
+	req.NoError(bc.AddSubscriber(mr))
+	req.NoError(bc.AddSubscriber(mb))
+
+	var calls []*gomock.Call
+	for h := uint64(3); h > 1; h-- {
+		blk, err := block.NewTestingBuilder().SetHeight(h).SignAndBuild(identityset.PrivateKey(27))
+		req.NoError(err)
+		calls = append(calls,
+			dao.EXPECT().GetBlockByHeight(h).Return(&blk, nil),
+			dao.EXPECT().GetReceipts(h).Return(nil, nil),
+			dao.EXPECT().DeleteBlockToTarget(h-1).Return(nil),
+		)
+	}
+	gomock.InOrder(calls...)
+	dao.EXPECT().Height().Return(uint64(3), nil).Times(1)
+	req.NoError(bc.DeleteBlockToTarget(1))
+	req.Equal(uint64(3), <-reverted)
+	req.Equal(uint64(2), <-reverted)
+
+	// no block is reverted on failure
+	dao.EXPECT().Height().Return(uint64(3), nil).Times(1)
+	dao.EXPECT().GetBlockByHeight(uint64(3)).Return(nil, errors.New("not found")).Times(1)
+	req.Error(bc.DeleteBlockToTarget(1))
+	req.NoError(bc.RemoveSubscriber(mr))
+	req.NoError(bc.RemoveSubscriber(mb))
+}
+
 func TestHistoryForAccount(t *testing.T) {
 	testHistoryForAccount(t, false)
 	testHistoryForAccount(t, true)
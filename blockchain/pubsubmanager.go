@@ -19,15 +19,22 @@ type PubSubManager interface {
 	AddBlockListener(BlockCreationSubscriber) error
 	RemoveBlockListener(BlockCreationSubscriber) error
 	SendBlockToSubscribers(*block.Block)
+	SendRevertedBlockToSubscribers(*block.Block)
 }
 
 // pubSubElem includes Subscriber, buffered channel for storing the pending blocks and cancel channel to end the handler thread
 type pubSubElem struct {
 	listener          BlockCreationSubscriber
-	pendingBlksBuffer chan *block.Block
+	pendingBlksBuffer chan *blockEvent
 	cancel            chan interface{}
 }
 
+// blockEvent is a created or reverted block, which are sent through the same channel to keep them in order
+type blockEvent struct {
+	blk      *block.Block
+	reverted bool
+}
+
 // pubSub defines array of blockListener to handle multi-thread publish/subscribe
 type pubSub struct {
 	blocklisteners       []*pubSubElem
@@ -44,7 +51,7 @@ func NewPubSub(bufferSize uint64) PubSubManager {
 
 // AddBlockListener creates new pubSubElem subscriber and append it to blocklisteners
 func (ps *pubSub) AddBlockListener(s BlockCreationSubscriber) error {
-	pendingBlksChan := make(chan *block.Block, ps.pendingBlkBufferSize)
+	pendingBlksChan := make(chan *blockEvent, ps.pendingBlkBufferSize)
 	cancelChan := make(chan interface{})
 	// create subscriber handler thread to handle pending blocks
 	go ps.handler(cancelChan, pendingBlksChan, s)
@@ -75,19 +82,37 @@ func (ps *pubSub) RemoveBlockListener(s BlockCreationSubscriber) error {
 // SendBlockToSubscribers sends block to every subscriber by using buffer channel
 func (ps *pubSub) SendBlockToSubscribers(blk *block.Block) {
 	for _, elem := range ps.blocklisteners {
-		elem.pendingBlksBuffer <- blk
+		elem.pendingBlksBuffer <- &blockEvent{blk: blk}
 	}
 	return
 }
 
-func (ps *pubSub) handler(cancelChan <-chan interface{}, pendingBlks <-chan *block.Block, s BlockCreationSubscriber) {
+// SendRevertedBlockToSubscribers sends reverted block to every subscriber by using buffer channel, the subscribers which
+// are not BlockRevertSubscriber ignore it
+func (ps *pubSub) SendRevertedBlockToSubscribers(blk *block.Block) {
+	for _, elem := range ps.blocklisteners {
+		elem.pendingBlksBuffer <- &blockEvent{blk: blk, reverted: true}
+	}
+}
+
+func (ps *pubSub) handler(cancelChan <-chan interface{}, pendingBlks <-chan *blockEvent, s BlockCreationSubscriber) {
+	rs, canRevert := s.(BlockRevertSubscriber)
 	for {
 		select {
 		case <-cancelChan:
 			return
-		case blk := <-pendingBlks:
-			if err := s.ReceiveBlock(blk); err != nil {
-				log.L().Error("Failed to handle new block.", zap.Error(err))
+		case evt := <-pendingBlks:
+			if !evt.reverted {
+				if err := s.ReceiveBlock(evt.blk); err != nil {
+					log.L().Error("Failed to handle new block.", zap.Error(err))
+				}
+				continue
+			}
+			if !canRevert {
+				continue
+			}
+			if err := rs.RevertBlock(evt.blk); err != nil {
+				log.L().Error("Failed to handle reverted block.", zap.Error(err))
 			}
 		}
 	}
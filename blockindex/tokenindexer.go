@@ -0,0 +1,544 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blockindex
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/blockdao"
+	"github.com/iotexproject/iotex-core/blockindex/indexpb"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/db/batch"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+)
+
+const (
+	// tokenHeightNS stores the tip height of token indexer
+	tokenHeightNS = "kh"
+	// tokenBlockNS stores the changes of each block, to revert the index of the tip block
+	tokenBlockNS = "kb"
+	// tokenBalanceNS stores the balance of token holders, keyed by token address + holder address
+	tokenBalanceNS = "kv"
+)
+
+var (
+	// tokenAddrPrefix is the prefix of the bucket of the token transfers of an address
+	tokenAddrPrefix = []byte("ka")
+	// tokenTransferPrefix is the prefix of the bucket of the transfers of a token
+	tokenTransferPrefix = []byte("kt")
+	// tokenHolderPrefix is the prefix of the bucket of the holders of a token
+	tokenHolderPrefix = []byte("ko")
+
+	tokenTipHeightKey = []byte("tipHeight")
+
+	// _transferEvent is the topic of event Transfer(address,address,uint256) of XRC20 and XRC721
+	_transferEvent = hash.Hash256b([]byte("Transfer(address,address,uint256)"))
+)
+
+type (
+	// TokenTransfer is a transfer of XRC20 or XRC721 token
+	TokenTransfer struct {
+		BlockHeight uint64
+		ActionHash  hash.Hash256
+		Token       string
+		Sender      string
+		Recipient   string
+		// Amount is the amount of XRC20 token, or the token id of XRC721 token
+		Amount *big.Int
+		XRC721 bool
+	}
+
+	// TokenHolder is a holder of a token with its balance, the balance of a XRC721 token is the number of tokens held
+	TokenHolder struct {
+		Address string
+		Balance *big.Int
+	}
+
+	// TokenIndexer is the interface of the indexer of XRC20 and XRC721 token transfers
+	TokenIndexer interface {
+		blockdao.BlockIndexer
+		// GetTokenTransferCountByAddress returns the total number of token transfers from or to an address
+		GetTokenTransferCountByAddress(hash.Hash160) (uint64, error)
+		// GetTokenTransfersByAddress returns token transfers[start, start+count) from or to an address
+		GetTokenTransfersByAddress(hash.Hash160, uint64, uint64) ([]*TokenTransfer, error)
+		// GetTokenTransferCountByToken returns the total number of transfers of a token
+		GetTokenTransferCountByToken(hash.Hash160) (uint64, error)
+		// GetTokenTransfersByToken returns transfers[start, start+count) of a token
+		GetTokenTransfersByToken(hash.Hash160, uint64, uint64) ([]*TokenTransfer, error)
+		// GetTokenBalance returns the balance of a holder of a token
+		GetTokenBalance(hash.Hash160, hash.Hash160) (*big.Int, error)
+		// GetTokenHolderCount returns the number of the addresses which have ever held a token
+		GetTokenHolderCount(hash.Hash160) (uint64, error)
+		// GetTokenHolders returns holders[start, start+count) of a token in the order of first receiving the token,
+		// including the holders whose balance has dropped to zero
+		GetTokenHolders(hash.Hash160, uint64, uint64) ([]*TokenHolder, error)
+	}
+
+	// tokenIndexer implements the TokenIndexer interface
+	tokenIndexer struct {
+		mutex   sync.RWMutex
+		kvStore db.KVStoreWithRange
+		batch   batch.KVStoreBatch
+		dirty   map[string]db.CountingIndex
+	}
+)
+
+// NewTokenIndexer creates a new token indexer
+func NewTokenIndexer(kv db.KVStore) (TokenIndexer, error) {
+	if kv == nil {
+		return nil, errors.New("empty kvStore")
+	}
+	kvRange, ok := kv.(db.KVStoreWithRange)
+	if !ok {
+		return nil, errors.New("indexer can only be created from KVStoreWithRange")
+	}
+	return &tokenIndexer{
+		kvStore: kvRange,
+		batch:   batch.NewBatch(),
+		dirty:   make(map[string]db.CountingIndex),
+	}, nil
+}
+
+// Start starts the indexer
+func (x *tokenIndexer) Start(ctx context.Context) error {
+	if err := x.kvStore.Start(ctx); err != nil {
+		return err
+	}
+	_, err := x.kvStore.Get(tokenHeightNS, tokenTipHeightKey)
+	if errors.Cause(err) == db.ErrNotExist {
+		return x.kvStore.Put(tokenHeightNS, tokenTipHeightKey, byteutil.Uint64ToBytesBigEndian(0))
+	}
+	return err
+}
+
+// Stop stops the indexer
+func (x *tokenIndexer) Stop(ctx context.Context) error {
+	return x.kvStore.Stop(ctx)
+}
+
+// Height returns the tip height of the indexer
+func (x *tokenIndexer) Height() (uint64, error) {
+	x.mutex.RLock()
+	defer x.mutex.RUnlock()
+	return x.height()
+}
+
+// PutBlock indexes the Transfer events in the receipts of the block
+func (x *tokenIndexer) PutBlock(_ context.Context, blk *block.Block) error {
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+
+	tipHeight, err := x.height()
+	if err != nil {
+		return err
+	}
+	height := blk.Height()
+	if height != tipHeight+1 {
+		return errors.Wrapf(db.ErrInvalid, "wrong block height %d, expecting %d", height, tipHeight+1)
+	}
+	var (
+		blkIndex = &indexpb.TokenBlockIndex{}
+		// balances changed in the block, keyed by token address + holder address
+		balances = make(map[string]*big.Int)
+	)
+	for _, receipt := range blk.Receipts {
+		if receipt.Status != uint64(iotextypes.ReceiptStatus_Success) {
+			continue
+		}
+		for _, l := range receipt.Logs() {
+			transfer, ok := parseTokenTransfer(l)
+			if !ok {
+				continue
+			}
+			value := byteutil.Must(proto.Marshal(&indexpb.TokenTransferIndex{
+				BlkHeight:  height,
+				ActionHash: receipt.ActionHash[:],
+				Token:      transfer.token[:],
+				Sender:     transfer.sender[:],
+				Recipient:  transfer.recipient[:],
+				Amount:     transfer.amount.String(),
+				Xrc721:     transfer.xrc721,
+			}))
+			buckets := [][]byte{tokenBucket(tokenTransferPrefix, transfer.token)}
+			for _, addr := range []hash.Hash160{transfer.sender, transfer.recipient} {
+				if addr == hash.ZeroHash160 {
+					// minted or burnt
+					continue
+				}
+				bucket := tokenBucket(tokenAddrPrefix, addr)
+				if len(buckets) == 2 && string(buckets[1]) == string(bucket) {
+					continue
+				}
+				buckets = append(buckets, bucket)
+			}
+			for _, bucket := range buckets {
+				if err := x.add(bucket, value); err != nil {
+					return err
+				}
+				blkIndex.Buckets = append(blkIndex.Buckets, bucket)
+			}
+
+			// the balance of a XRC721 token is the number of tokens held
+			delta := transfer.amount
+			if transfer.xrc721 {
+				delta = big.NewInt(1)
+			}
+			for _, c := range []struct {
+				holder hash.Hash160
+				add    bool
+			}{
+				{transfer.sender, false},
+				{transfer.recipient, true},
+			} {
+				holder := c.holder
+				if holder == hash.ZeroHash160 {
+					continue
+				}
+				key := tokenBalanceKey(transfer.token, holder)
+				balance, ok := balances[string(key)]
+				if !ok {
+					prev, exist, err := x.balance(key)
+					if err != nil {
+						return err
+					}
+					blkIndex.Balances = append(blkIndex.Balances, &indexpb.TokenBalanceIndex{
+						Token:     transfer.token[:],
+						Holder:    holder[:],
+						Balance:   prev.String(),
+						NewHolder: !exist,
+					})
+					if !exist {
+						bucket := tokenBucket(tokenHolderPrefix, transfer.token)
+						if err := x.add(bucket, holder[:]); err != nil {
+							return err
+						}
+						blkIndex.Buckets = append(blkIndex.Buckets, bucket)
+					}
+					balance = prev
+				}
+				if c.add {
+					balance = new(big.Int).Add(balance, delta)
+				} else if balance = new(big.Int).Sub(balance, delta); balance.Sign() < 0 {
+					// a non-compliant contract may transfer more than the balance
+					balance = big.NewInt(0)
+				}
+				balances[string(key)] = balance
+			}
+		}
+	}
+	for k, v := range balances {
+		x.batch.Put(tokenBalanceNS, []byte(k), v.Bytes(), "failed to put balance of %x", k)
+	}
+	if len(blkIndex.Buckets) > 0 {
+		x.batch.Put(tokenBlockNS, byteutil.Uint64ToBytesBigEndian(height), byteutil.Must(proto.Marshal(blkIndex)), "failed to put index of block %d", height)
+	}
+	x.batch.Put(tokenHeightNS, tokenTipHeightKey, byteutil.Uint64ToBytesBigEndian(height), "failed to put tip height %d", height)
+	return x.commit()
+}
+
+// DeleteTipBlock deletes the index of the tip block
+func (x *tokenIndexer) DeleteTipBlock(blk *block.Block) error {
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+
+	tipHeight, err := x.height()
+	if err != nil {
+		return err
+	}
+	height := blk.Height()
+	if height != tipHeight {
+		return errors.Wrapf(db.ErrInvalid, "wrong block height %d, expecting %d", height, tipHeight)
+	}
+	blkIndex := &indexpb.TokenBlockIndex{}
+	value, err := x.kvStore.Get(tokenBlockNS, byteutil.Uint64ToBytesBigEndian(height))
+	switch errors.Cause(err) {
+	case nil:
+		if err := proto.Unmarshal(value, blkIndex); err != nil {
+			return err
+		}
+	case db.ErrNotExist:
+	default:
+		return err
+	}
+	counts := make(map[string]uint64)
+	for _, bucket := range blkIndex.Buckets {
+		counts[string(bucket)]++
+	}
+	for bucket, count := range counts {
+		// counting index reverts the entries directly rather than in batch mode
+		indexer, err := db.GetCountingIndex(x.kvStore, []byte(bucket))
+		if err != nil {
+			return err
+		}
+		if err := indexer.Revert(count); err != nil {
+			return err
+		}
+	}
+	for _, b := range blkIndex.Balances {
+		key := tokenBalanceKey(hash.BytesToHash160(b.Token), hash.BytesToHash160(b.Holder))
+		if b.NewHolder {
+			x.batch.Delete(tokenBalanceNS, key, "failed to delete balance of %x", key)
+			continue
+		}
+		balance, ok := new(big.Int).SetString(b.Balance, 10)
+		if !ok {
+			return errors.Errorf("invalid balance %s", b.Balance)
+		}
+		x.batch.Put(tokenBalanceNS, key, balance.Bytes(), "failed to put balance of %x", key)
+	}
+	x.batch.Delete(tokenBlockNS, byteutil.Uint64ToBytesBigEndian(height), "failed to delete index of block %d", height)
+	x.batch.Put(tokenHeightNS, tokenTipHeightKey, byteutil.Uint64ToBytesBigEndian(height-1), "failed to put tip height %d", height-1)
+	return x.commit()
+}
+
+// GetTokenTransferCountByAddress returns the total number of token transfers from or to an address
+func (x *tokenIndexer) GetTokenTransferCountByAddress(addr hash.Hash160) (uint64, error) {
+	return x.count(tokenBucket(tokenAddrPrefix, addr))
+}
+
+// GetTokenTransfersByAddress returns token transfers[start, start+count) from or to an address
+func (x *tokenIndexer) GetTokenTransfersByAddress(addr hash.Hash160, start, count uint64) ([]*TokenTransfer, error) {
+	return x.transfers(tokenBucket(tokenAddrPrefix, addr), start, count)
+}
+
+// GetTokenTransferCountByToken returns the total number of transfers of a token
+func (x *tokenIndexer) GetTokenTransferCountByToken(token hash.Hash160) (uint64, error) {
+	return x.count(tokenBucket(tokenTransferPrefix, token))
+}
+
+// GetTokenTransfersByToken returns transfers[start, start+count) of a token
+func (x *tokenIndexer) GetTokenTransfersByToken(token hash.Hash160, start, count uint64) ([]*TokenTransfer, error) {
+	return x.transfers(tokenBucket(tokenTransferPrefix, token), start, count)
+}
+
+// GetTokenBalance returns the balance of a holder of a token
+func (x *tokenIndexer) GetTokenBalance(token hash.Hash160, holder hash.Hash160) (*big.Int, error) {
+	x.mutex.RLock()
+	defer x.mutex.RUnlock()
+
+	balance, _, err := x.balance(tokenBalanceKey(token, holder))
+	return balance, err
+}
+
+// GetTokenHolderCount returns the number of the addresses which have ever held a token
+func (x *tokenIndexer) GetTokenHolderCount(token hash.Hash160) (uint64, error) {
+	return x.count(tokenBucket(tokenHolderPrefix, token))
+}
+
+// GetTokenHolders returns holders[start, start+count) of a token
+func (x *tokenIndexer) GetTokenHolders(token hash.Hash160, start, count uint64) ([]*TokenHolder, error) {
+	x.mutex.RLock()
+	defer x.mutex.RUnlock()
+
+	values, err := x.rangeOf(tokenBucket(tokenHolderPrefix, token), start, count)
+	if err != nil {
+		return nil, err
+	}
+	holders := make([]*TokenHolder, 0, len(values))
+	for _, v := range values {
+		holder := hash.BytesToHash160(v)
+		balance, _, err := x.balance(tokenBalanceKey(token, holder))
+		if err != nil {
+			return nil, err
+		}
+		addr, err := address.FromBytes(holder[:])
+		if err != nil {
+			return nil, err
+		}
+		holders = append(holders, &TokenHolder{
+			Address: addr.String(),
+			Balance: balance,
+		})
+	}
+	return holders, nil
+}
+
+func (x *tokenIndexer) height() (uint64, error) {
+	value, err := x.kvStore.Get(tokenHeightNS, tokenTipHeightKey)
+	if err != nil {
+		return 0, err
+	}
+	return byteutil.BytesToUint64BigEndian(value), nil
+}
+
+// balance returns the balance by the key, and whether the holder exists
+func (x *tokenIndexer) balance(key []byte) (*big.Int, bool, error) {
+	value, err := x.kvStore.Get(tokenBalanceNS, key)
+	switch errors.Cause(err) {
+	case nil:
+		return new(big.Int).SetBytes(value), true, nil
+	case db.ErrNotExist, db.ErrBucketNotExist:
+		return big.NewInt(0), false, nil
+	default:
+		return nil, false, err
+	}
+}
+
+func (x *tokenIndexer) count(bucket []byte) (uint64, error) {
+	x.mutex.RLock()
+	defer x.mutex.RUnlock()
+
+	indexer, err := db.GetCountingIndex(x.kvStore, bucket)
+	if err != nil {
+		if errors.Cause(err) == db.ErrBucketNotExist || errors.Cause(err) == db.ErrNotExist {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return indexer.Size(), nil
+}
+
+func (x *tokenIndexer) transfers(bucket []byte, start, count uint64) ([]*TokenTransfer, error) {
+	x.mutex.RLock()
+	defer x.mutex.RUnlock()
+
+	values, err := x.rangeOf(bucket, start, count)
+	if err != nil {
+		return nil, err
+	}
+	transfers := make([]*TokenTransfer, 0, len(values))
+	for _, v := range values {
+		pb := &indexpb.TokenTransferIndex{}
+		if err := proto.Unmarshal(v, pb); err != nil {
+			return nil, err
+		}
+		transfer, err := tokenTransferFromPb(pb)
+		if err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, transfer)
+	}
+	return transfers, nil
+}
+
+func (x *tokenIndexer) rangeOf(bucket []byte, start, count uint64) ([][]byte, error) {
+	indexer, err := db.GetCountingIndex(x.kvStore, bucket)
+	if err != nil {
+		return nil, err
+	}
+	total := indexer.Size()
+	if start >= total {
+		return nil, errors.Wrapf(db.ErrInvalid, "start = %d >= total = %d", start, total)
+	}
+	if start+count > total {
+		count = total - start
+	}
+	return indexer.Range(start, count)
+}
+
+// add appends the value to the counting index of the bucket, which is placed into a dirty map to be committed
+func (x *tokenIndexer) add(bucket []byte, value []byte) error {
+	indexer, ok := x.dirty[string(bucket)]
+	if !ok {
+		var err error
+		indexer, err = db.NewCountingIndexNX(x.kvStore, bucket)
+		if err != nil {
+			return err
+		}
+		if err := indexer.UseBatch(x.batch); err != nil {
+			return err
+		}
+		x.dirty[string(bucket)] = indexer
+	}
+	return indexer.Add(value, true)
+}
+
+// commit writes the changes
+func (x *tokenIndexer) commit() error {
+	var commitErr error
+	for k, v := range x.dirty {
+		if commitErr == nil {
+			if err := v.Finalize(); err != nil {
+				commitErr = err
+			}
+		}
+		delete(x.dirty, k)
+	}
+	if commitErr == nil {
+		commitErr = x.kvStore.WriteBatch(x.batch)
+	}
+	x.batch.Clear()
+	return commitErr
+}
+
+type tokenTransfer struct {
+	token, sender, recipient hash.Hash160
+	amount                   *big.Int
+	xrc721                   bool
+}
+
+// parseTokenTransfer parses the Transfer event of XRC20 and XRC721 token. The amount of XRC20 is in the data, while
+// the token id of XRC721 is indexed as the 3rd topic
+func parseTokenTransfer(l *action.Log) (*tokenTransfer, bool) {
+	if l == nil || len(l.Topics) == 0 || l.Topics[0] != _transferEvent {
+		return nil, false
+	}
+	token, err := address.FromString(l.Address)
+	if err != nil {
+		return nil, false
+	}
+	transfer := &tokenTransfer{token: hash.BytesToHash160(token.Bytes())}
+	switch {
+	case len(l.Topics) == 3 && len(l.Data) == 32:
+		transfer.amount = new(big.Int).SetBytes(l.Data)
+	case len(l.Topics) == 4 && len(l.Data) == 0:
+		transfer.amount = new(big.Int).SetBytes(l.Topics[3][:])
+		transfer.xrc721 = true
+	default:
+		return nil, false
+	}
+	// the address is left padded to 32 bytes in the topic
+	transfer.sender = hash.BytesToHash160(l.Topics[1][12:])
+	transfer.recipient = hash.BytesToHash160(l.Topics[2][12:])
+	return transfer, true
+}
+
+func tokenTransferFromPb(pb *indexpb.TokenTransferIndex) (*TokenTransfer, error) {
+	amount, ok := new(big.Int).SetString(pb.Amount, 10)
+	if !ok {
+		return nil, errors.Errorf("invalid amount %s", pb.Amount)
+	}
+	transfer := &TokenTransfer{
+		BlockHeight: pb.BlkHeight,
+		ActionHash:  hash.BytesToHash256(pb.ActionHash),
+		Amount:      amount,
+		XRC721:      pb.Xrc721,
+	}
+	for _, c := range []struct {
+		b []byte
+		s *string
+	}{
+		{pb.Token, &transfer.Token},
+		{pb.Sender, &transfer.Sender},
+		{pb.Recipient, &transfer.Recipient},
+	} {
+		addr, err := address.FromBytes(c.b)
+		if err != nil {
+			return nil, err
+		}
+		*c.s = addr.String()
+	}
+	return transfer, nil
+}
+
+func tokenBucket(prefix []byte, addr hash.Hash160) []byte {
+	return append(append([]byte{}, prefix...), addr[:]...)
+}
+
+func tokenBalanceKey(token, holder hash.Hash160) []byte {
+	return append(append([]byte{}, token[:]...), holder[:]...)
+}
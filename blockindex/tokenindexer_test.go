@@ -0,0 +1,221 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blockindex
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestTokenIndexer(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		xrc20  = identityset.Address(31)
+		xrc721 = identityset.Address(32)
+		mint   = hash.ZeroHash160
+	)
+	addrHash := func(i int) hash.Hash160 {
+		return hash.BytesToHash160(identityset.Address(i).Bytes())
+	}
+	topic := func(h hash.Hash160) hash.Hash256 {
+		var t hash.Hash256
+		copy(t[12:], h[:])
+		return t
+	}
+	xrc20Log := func(sender, recipient hash.Hash160, amount int64) *action.Log {
+		data := hash.BytesToHash256(big.NewInt(amount).Bytes())
+		return &action.Log{
+			Address: xrc20.String(),
+			Topics:  action.Topics{_transferEvent, topic(sender), topic(recipient)},
+			Data:    data[:],
+		}
+	}
+	xrc721Log := func(sender, recipient hash.Hash160, id int64) *action.Log {
+		return &action.Log{
+			Address: xrc721.String(),
+			Topics:  action.Topics{_transferEvent, topic(sender), topic(recipient), hash.BytesToHash256(big.NewInt(id).Bytes())},
+		}
+	}
+	newReceipt := func(status iotextypes.ReceiptStatus, logs ...*action.Log) *action.Receipt {
+		return (&action.Receipt{
+			Status:     uint64(status),
+			ActionHash: hash.Hash256b([]byte{byte(len(logs))}),
+		}).AddLogs(logs...)
+	}
+	newBlock := func(height uint64, receipts ...*action.Receipt) *block.Block {
+		blk, err := block.NewTestingBuilder().
+			SetHeight(height).
+			SetTimeStamp(testutil.TimestampNow()).
+			SignAndBuild(identityset.PrivateKey(27))
+		require.NoError(err)
+		blk.Receipts = receipts
+		return &blk
+	}
+
+	blks := []*block.Block{
+		newBlock(1,
+			newReceipt(iotextypes.ReceiptStatus_Success,
+				xrc20Log(mint, addrHash(28), 100),
+				xrc721Log(mint, addrHash(28), 1),
+				xrc721Log(mint, addrHash(28), 2),
+				// not a transfer event
+				&action.Log{Address: xrc20.String(), Topics: action.Topics{hash.Hash256b([]byte("Approval"))}},
+			),
+		),
+		newBlock(2,
+			newReceipt(iotextypes.ReceiptStatus_Success,
+				xrc20Log(addrHash(28), addrHash(29), 30),
+				xrc721Log(addrHash(28), addrHash(29), 2),
+			),
+			newReceipt(iotextypes.ReceiptStatus_Failure,
+				xrc20Log(addrHash(28), addrHash(30), 10),
+			),
+		),
+		newBlock(3,
+			newReceipt(iotextypes.ReceiptStatus_Success,
+				xrc20Log(addrHash(29), addrHash(30), 30),
+				// self transfer is indexed once
+				xrc20Log(addrHash(28), addrHash(28), 10),
+			),
+		),
+	}
+
+	checkBalances := func(indexer TokenIndexer, token hash.Hash160, expected map[int]int64) {
+		for i, b := range expected {
+			balance, err := indexer.GetTokenBalance(token, addrHash(i))
+			require.NoError(err)
+			require.Equal(big.NewInt(b), balance)
+		}
+	}
+	checkCounts := func(indexer TokenIndexer, expected map[int]uint64) {
+		for i, c := range expected {
+			count, err := indexer.GetTokenTransferCountByAddress(addrHash(i))
+			require.NoError(err)
+			require.Equal(c, count)
+		}
+	}
+
+	testIndexer := func(kvStore db.KVStore, t *testing.T) {
+		ctx := context.Background()
+		indexer, err := NewTokenIndexer(kvStore)
+		require.NoError(err)
+		require.NoError(indexer.Start(ctx))
+		defer func() {
+			require.NoError(indexer.Stop(ctx))
+		}()
+
+		require.Equal(db.ErrInvalid, errors.Cause(indexer.PutBlock(ctx, blks[1])))
+		for _, blk := range blks {
+			require.NoError(indexer.PutBlock(ctx, blk))
+		}
+		height, err := indexer.Height()
+		require.NoError(err)
+		require.EqualValues(3, height)
+
+		xrc20Hash := hash.BytesToHash160(xrc20.Bytes())
+		xrc721Hash := hash.BytesToHash160(xrc721.Bytes())
+		checkBalances(indexer, xrc20Hash, map[int]int64{28: 70, 29: 0, 30: 30, 31: 0})
+		checkBalances(indexer, xrc721Hash, map[int]int64{28: 1, 29: 1, 30: 0})
+		checkCounts(indexer, map[int]uint64{28: 6, 29: 3, 30: 1, 31: 0})
+
+		count, err := indexer.GetTokenTransferCountByToken(xrc20Hash)
+		require.NoError(err)
+		require.EqualValues(4, count)
+		transfers, err := indexer.GetTokenTransfersByToken(xrc20Hash, 1, 2)
+		require.NoError(err)
+		require.Len(transfers, 2)
+		require.EqualValues(2, transfers[0].BlockHeight)
+		require.Equal(xrc20.String(), transfers[0].Token)
+		require.Equal(identityset.Address(28).String(), transfers[0].Sender)
+		require.Equal(identityset.Address(29).String(), transfers[0].Recipient)
+		require.Equal(big.NewInt(30), transfers[0].Amount)
+		require.False(transfers[0].XRC721)
+		require.Equal(identityset.Address(30).String(), transfers[1].Recipient)
+
+		transfers, err = indexer.GetTokenTransfersByAddress(addrHash(29), 0, 10)
+		require.NoError(err)
+		require.Len(transfers, 3)
+		require.Equal(xrc721.String(), transfers[1].Token)
+		require.True(transfers[1].XRC721)
+		require.Equal(big.NewInt(2), transfers[1].Amount)
+		_, err = indexer.GetTokenTransfersByAddress(addrHash(29), 3, 1)
+		require.Equal(db.ErrInvalid, errors.Cause(err))
+
+		count, err = indexer.GetTokenHolderCount(xrc20Hash)
+		require.NoError(err)
+		require.EqualValues(3, count)
+		holders, err := indexer.GetTokenHolders(xrc20Hash, 0, 10)
+		require.NoError(err)
+		require.Len(holders, 3)
+		for i, h := range []struct {
+			addr    int
+			balance int64
+		}{
+			{28, 70}, {29, 0}, {30, 30},
+		} {
+			require.Equal(identityset.Address(h.addr).String(), holders[i].Address)
+			require.Equal(big.NewInt(h.balance), holders[i].Balance)
+		}
+
+		// delete tip blocks
+		require.Equal(db.ErrInvalid, errors.Cause(indexer.DeleteTipBlock(blks[1])))
+		require.NoError(indexer.DeleteTipBlock(blks[2]))
+		checkBalances(indexer, xrc20Hash, map[int]int64{28: 70, 29: 30, 30: 0})
+		checkCounts(indexer, map[int]uint64{28: 5, 29: 2, 30: 0})
+		count, err = indexer.GetTokenHolderCount(xrc20Hash)
+		require.NoError(err)
+		require.EqualValues(2, count)
+
+		require.NoError(indexer.DeleteTipBlock(blks[1]))
+		checkBalances(indexer, xrc20Hash, map[int]int64{28: 100, 29: 0})
+		checkBalances(indexer, xrc721Hash, map[int]int64{28: 2, 29: 0})
+		require.NoError(indexer.DeleteTipBlock(blks[0]))
+		checkBalances(indexer, xrc20Hash, map[int]int64{28: 0})
+		checkCounts(indexer, map[int]uint64{28: 0, 29: 0})
+		for _, token := range []hash.Hash160{xrc20Hash, xrc721Hash} {
+			count, err := indexer.GetTokenTransferCountByToken(token)
+			require.NoError(err)
+			require.Zero(count)
+			count, err = indexer.GetTokenHolderCount(token)
+			require.NoError(err)
+			require.Zero(count)
+		}
+
+		// index again after revert
+		for _, blk := range blks {
+			require.NoError(indexer.PutBlock(ctx, blk))
+		}
+		checkBalances(indexer, xrc20Hash, map[int]int64{28: 70, 29: 0, 30: 30})
+	}
+
+	t.Run("In-memory KV indexer", func(t *testing.T) {
+		testIndexer(db.NewMemKVStore(), t)
+	})
+	testPath, err := testutil.PathOfTempFile("test-token-indexer")
+	require.NoError(err)
+	cfg := config.Default.DB
+	cfg.DbPath = testPath
+	t.Run("Bolt DB indexer", func(t *testing.T) {
+		testutil.CleanupPath(t, testPath)
+		defer testutil.CleanupPath(t, testPath)
+		testIndexer(db.NewBoltDB(cfg), t)
+	})
+}
@@ -0,0 +1,337 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blockindex
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/blockdao"
+	"github.com/iotexproject/iotex-core/blockindex/indexpb"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/db/batch"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+)
+
+const (
+	// transferHeightNS stores the tip height of transfer indexer
+	transferHeightNS = "th"
+	// transferBlockNS stores the addresses indexed in each block, to revert the index of the tip block
+	transferBlockNS = "tb"
+)
+
+var (
+	// transferAddrPrefix is the prefix of the bucket of the counting index of an address
+	transferAddrPrefix   = []byte("ta")
+	transferTipHeightKey = []byte("tipHeight")
+)
+
+type (
+	// Transfer is a native token transfer recorded in the transaction logs of an action, including the transfers in
+	// the internal calls of contract, staking, rewarding and gas fee
+	Transfer struct {
+		BlockHeight uint64
+		ActionHash  hash.Hash256
+		Type        iotextypes.TransactionLogType
+		Amount      *big.Int
+		Sender      string
+		Recipient   string
+	}
+
+	// TransferIndexer is the interface of the indexer of native token transfers by address
+	TransferIndexer interface {
+		blockdao.TransactionLogIndexer
+		// GetTransferCountByAddress returns the total number of transfers from or to an address
+		GetTransferCountByAddress(hash.Hash160) (uint64, error)
+		// GetTransfersByAddress returns transfers[start, start+count) from or to an address, in the order of height
+		GetTransfersByAddress(hash.Hash160, uint64, uint64) ([]*Transfer, error)
+	}
+
+	// transferIndexer implements the TransferIndexer interface
+	transferIndexer struct {
+		mutex     sync.RWMutex
+		kvStore   db.KVStoreWithRange
+		batch     batch.KVStoreBatch
+		dirtyAddr addrIndex
+	}
+)
+
+// NewTransferIndexer creates a new transfer indexer
+func NewTransferIndexer(kv db.KVStore) (TransferIndexer, error) {
+	if kv == nil {
+		return nil, errors.New("empty kvStore")
+	}
+	kvRange, ok := kv.(db.KVStoreWithRange)
+	if !ok {
+		return nil, errors.New("indexer can only be created from KVStoreWithRange")
+	}
+	return &transferIndexer{
+		kvStore:   kvRange,
+		batch:     batch.NewBatch(),
+		dirtyAddr: make(addrIndex),
+	}, nil
+}
+
+// Start starts the indexer
+func (x *transferIndexer) Start(ctx context.Context) error {
+	if err := x.kvStore.Start(ctx); err != nil {
+		return err
+	}
+	_, err := x.kvStore.Get(transferHeightNS, transferTipHeightKey)
+	if errors.Cause(err) == db.ErrNotExist {
+		return x.kvStore.Put(transferHeightNS, transferTipHeightKey, byteutil.Uint64ToBytesBigEndian(0))
+	}
+	return err
+}
+
+// Stop stops the indexer
+func (x *transferIndexer) Stop(ctx context.Context) error {
+	return x.kvStore.Stop(ctx)
+}
+
+// RequireTransactionLog returns true, because the transfers are indexed from the transaction logs
+func (x *transferIndexer) RequireTransactionLog() bool {
+	return true
+}
+
+// Height returns the tip height of the indexer
+func (x *transferIndexer) Height() (uint64, error) {
+	x.mutex.RLock()
+	defer x.mutex.RUnlock()
+	return x.height()
+}
+
+// PutBlock indexes the transfers in the transaction logs of the receipts of the block
+func (x *transferIndexer) PutBlock(_ context.Context, blk *block.Block) error {
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+
+	// the block to be indexed must be exactly current top + 1, otherwise counting index would not work correctly
+	tipHeight, err := x.height()
+	if err != nil {
+		return err
+	}
+	height := blk.Height()
+	if height != tipHeight+1 {
+		return errors.Wrapf(db.ErrInvalid, "wrong block height %d, expecting %d", height, tipHeight+1)
+	}
+	var indexed []byte
+	for _, receipt := range blk.Receipts {
+		for _, l := range receipt.TransactionLogs() {
+			if l == nil || l.Amount == nil || l.Amount.Sign() == 0 {
+				continue
+			}
+			value := byteutil.Must(proto.Marshal(&indexpb.TransferIndex{
+				BlkHeight:  height,
+				ActionHash: receipt.ActionHash[:],
+				Type:       int32(l.Type),
+				Amount:     l.Amount.String(),
+				Sender:     l.Sender,
+				Recipient:  l.Recipient,
+			}))
+			addrs, err := transferAddresses(l.Sender, l.Recipient)
+			if err != nil {
+				return err
+			}
+			for _, addr := range addrs {
+				indexer, err := x.getIndexerForAddr(addr)
+				if err != nil {
+					return err
+				}
+				if err := indexer.Add(value, true); err != nil {
+					return err
+				}
+				indexed = append(indexed, addr[:]...)
+			}
+		}
+	}
+	if len(indexed) > 0 {
+		x.batch.Put(transferBlockNS, byteutil.Uint64ToBytesBigEndian(height), indexed, "failed to put indexed addresses of block %d", height)
+	}
+	x.batch.Put(transferHeightNS, transferTipHeightKey, byteutil.Uint64ToBytesBigEndian(height), "failed to put tip height %d", height)
+	return x.commit()
+}
+
+// DeleteTipBlock deletes the index of the tip block
+func (x *transferIndexer) DeleteTipBlock(blk *block.Block) error {
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+
+	tipHeight, err := x.height()
+	if err != nil {
+		return err
+	}
+	height := blk.Height()
+	if height != tipHeight {
+		return errors.Wrapf(db.ErrInvalid, "wrong block height %d, expecting %d", height, tipHeight)
+	}
+	indexed, err := x.kvStore.Get(transferBlockNS, byteutil.Uint64ToBytesBigEndian(height))
+	switch errors.Cause(err) {
+	case nil:
+	case db.ErrNotExist:
+		indexed = nil
+	default:
+		return err
+	}
+	if len(indexed)%len(hash.ZeroHash160) != 0 {
+		return errors.Wrapf(db.ErrInvalid, "invalid indexed addresses of block %d", height)
+	}
+	counts := make(map[hash.Hash160]uint64)
+	for i := 0; i < len(indexed); i += len(hash.ZeroHash160) {
+		counts[hash.BytesToHash160(indexed[i:i+len(hash.ZeroHash160)])]++
+	}
+	for addr, count := range counts {
+		// counting index reverts the entries directly rather than in batch mode
+		indexer, err := db.GetCountingIndex(x.kvStore, transferAddrBucket(addr))
+		if err != nil {
+			return err
+		}
+		if err := indexer.Revert(count); err != nil {
+			return err
+		}
+	}
+	x.batch.Delete(transferBlockNS, byteutil.Uint64ToBytesBigEndian(height), "failed to delete indexed addresses of block %d", height)
+	x.batch.Put(transferHeightNS, transferTipHeightKey, byteutil.Uint64ToBytesBigEndian(height-1), "failed to put tip height %d", height-1)
+	return x.commit()
+}
+
+// GetTransferCountByAddress returns the total number of transfers from or to an address
+func (x *transferIndexer) GetTransferCountByAddress(addr hash.Hash160) (uint64, error) {
+	x.mutex.RLock()
+	defer x.mutex.RUnlock()
+
+	indexer, err := db.GetCountingIndex(x.kvStore, transferAddrBucket(addr))
+	if err != nil {
+		if errors.Cause(err) == db.ErrBucketNotExist || errors.Cause(err) == db.ErrNotExist {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return indexer.Size(), nil
+}
+
+// GetTransfersByAddress returns transfers[start, start+count) from or to an address
+func (x *transferIndexer) GetTransfersByAddress(addr hash.Hash160, start, count uint64) ([]*Transfer, error) {
+	x.mutex.RLock()
+	defer x.mutex.RUnlock()
+
+	indexer, err := db.GetCountingIndex(x.kvStore, transferAddrBucket(addr))
+	if err != nil {
+		return nil, err
+	}
+	total := indexer.Size()
+	if start >= total {
+		return nil, errors.Wrapf(db.ErrInvalid, "start = %d >= total = %d", start, total)
+	}
+	if start+count > total {
+		count = total - start
+	}
+	values, err := indexer.Range(start, count)
+	if err != nil {
+		return nil, err
+	}
+	transfers := make([]*Transfer, 0, len(values))
+	for _, v := range values {
+		pb := &indexpb.TransferIndex{}
+		if err := proto.Unmarshal(v, pb); err != nil {
+			return nil, err
+		}
+		amount, ok := new(big.Int).SetString(pb.Amount, 10)
+		if !ok {
+			return nil, errors.Errorf("invalid amount %s", pb.Amount)
+		}
+		transfers = append(transfers, &Transfer{
+			BlockHeight: pb.BlkHeight,
+			ActionHash:  hash.BytesToHash256(pb.ActionHash),
+			Type:        iotextypes.TransactionLogType(pb.Type),
+			Amount:      amount,
+			Sender:      pb.Sender,
+			Recipient:   pb.Recipient,
+		})
+	}
+	return transfers, nil
+}
+
+func (x *transferIndexer) height() (uint64, error) {
+	value, err := x.kvStore.Get(transferHeightNS, transferTipHeightKey)
+	if err != nil {
+		return 0, err
+	}
+	return byteutil.BytesToUint64BigEndian(value), nil
+}
+
+// getIndexerForAddr returns the counting indexer for an address, which is placed into a dirty map to be committed
+func (x *transferIndexer) getIndexerForAddr(addr hash.Hash160) (db.CountingIndex, error) {
+	indexer, ok := x.dirtyAddr[addr]
+	if !ok {
+		var err error
+		indexer, err = db.NewCountingIndexNX(x.kvStore, transferAddrBucket(addr))
+		if err != nil {
+			return nil, err
+		}
+		if err := indexer.UseBatch(x.batch); err != nil {
+			return nil, err
+		}
+		x.dirtyAddr[addr] = indexer
+	}
+	return indexer, nil
+}
+
+// commit writes the changes
+func (x *transferIndexer) commit() error {
+	var commitErr error
+	for k, v := range x.dirtyAddr {
+		if commitErr == nil {
+			if err := v.Finalize(); err != nil {
+				commitErr = err
+			}
+		}
+		delete(x.dirtyAddr, k)
+	}
+	if commitErr != nil {
+		x.batch.Clear()
+		return commitErr
+	}
+	if err := x.kvStore.WriteBatch(x.batch); err != nil {
+		x.batch.Clear()
+		return err
+	}
+	x.batch.Clear()
+	return nil
+}
+
+// transferAddresses returns the addresses to index a transfer, which is indexed once if the sender is the recipient
+func transferAddresses(sender, recipient string) ([]hash.Hash160, error) {
+	var addrs []hash.Hash160
+	for _, s := range []string{sender, recipient} {
+		if s == "" {
+			continue
+		}
+		addr, err := address.FromString(s)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid address %s in transaction log", s)
+		}
+		h := hash.BytesToHash160(addr.Bytes())
+		if len(addrs) == 1 && addrs[0] == h {
+			continue
+		}
+		addrs = append(addrs, h)
+	}
+	return addrs, nil
+}
+
+func transferAddrBucket(addr hash.Hash160) []byte {
+	return append(append([]byte{}, transferAddrPrefix...), addr[:]...)
+}
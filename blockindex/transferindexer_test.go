@@ -0,0 +1,176 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blockindex
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestTransferIndexer(t *testing.T) {
+	require := require.New(t)
+
+	addrHash := func(i int) hash.Hash160 {
+		return hash.BytesToHash160(identityset.Address(i).Bytes())
+	}
+	txLog := func(typ iotextypes.TransactionLogType, amount int64, sender, recipient int) *action.TransactionLog {
+		return &action.TransactionLog{
+			Type:      typ,
+			Amount:    big.NewInt(amount),
+			Sender:    identityset.Address(sender).String(),
+			Recipient: identityset.Address(recipient).String(),
+		}
+	}
+	actHash := func(i byte) hash.Hash256 {
+		return hash.Hash256b([]byte{i})
+	}
+	newBlock := func(height uint64, receipts ...*action.Receipt) *block.Block {
+		blk, err := block.NewTestingBuilder().
+			SetHeight(height).
+			SetTimeStamp(testutil.TimestampNow()).
+			SignAndBuild(identityset.PrivateKey(27))
+		require.NoError(err)
+		blk.Receipts = receipts
+		return &blk
+	}
+
+	blks := []*block.Block{
+		newBlock(1,
+			(&action.Receipt{ActionHash: actHash(1)}).AddTransactionLogs(
+				txLog(iotextypes.TransactionLogType_GAS_FEE, 10, 28, 0),
+				txLog(iotextypes.TransactionLogType_NATIVE_TRANSFER, 100, 28, 29),
+			),
+			(&action.Receipt{ActionHash: actHash(2)}).AddTransactionLogs(
+				txLog(iotextypes.TransactionLogType_IN_CONTRACT_TRANSFER, 5, 30, 29),
+				// zero amount is not indexed
+				txLog(iotextypes.TransactionLogType_IN_CONTRACT_TRANSFER, 0, 30, 28),
+			),
+		),
+		newBlock(2),
+		newBlock(3,
+			(&action.Receipt{ActionHash: actHash(3)}).AddTransactionLogs(
+				// self transfer is indexed once
+				txLog(iotextypes.TransactionLogType_NATIVE_TRANSFER, 7, 29, 29),
+				txLog(iotextypes.TransactionLogType_CLAIM_FROM_REWARDING_FUND, 20, 0, 28),
+			),
+		),
+	}
+
+	testIndexer := func(kvStore db.KVStore, t *testing.T) {
+		ctx := context.Background()
+		indexer, err := NewTransferIndexer(kvStore)
+		require.NoError(err)
+		require.NoError(indexer.Start(ctx))
+		defer func() {
+			require.NoError(indexer.Stop(ctx))
+		}()
+
+		// the block must be tip + 1
+		require.Equal(db.ErrInvalid, errors.Cause(indexer.PutBlock(ctx, blks[1])))
+		for _, blk := range blks {
+			require.NoError(indexer.PutBlock(ctx, blk))
+		}
+		height, err := indexer.Height()
+		require.NoError(err)
+		require.EqualValues(3, height)
+
+		for _, c := range []struct {
+			addr  int
+			count uint64
+		}{
+			{0, 2}, {28, 3}, {29, 3}, {30, 1}, {31, 0},
+		} {
+			count, err := indexer.GetTransferCountByAddress(addrHash(c.addr))
+			require.NoError(err)
+			require.Equal(c.count, count)
+		}
+
+		transfers, err := indexer.GetTransfersByAddress(addrHash(29), 0, 10)
+		require.NoError(err)
+		require.Len(transfers, 3)
+		require.EqualValues(1, transfers[0].BlockHeight)
+		require.Equal(actHash(1), transfers[0].ActionHash)
+		require.Equal(iotextypes.TransactionLogType_NATIVE_TRANSFER, transfers[0].Type)
+		require.Equal(big.NewInt(100), transfers[0].Amount)
+		require.Equal(identityset.Address(28).String(), transfers[0].Sender)
+		require.Equal(identityset.Address(29).String(), transfers[0].Recipient)
+		require.Equal(actHash(2), transfers[1].ActionHash)
+		require.Equal(iotextypes.TransactionLogType_IN_CONTRACT_TRANSFER, transfers[1].Type)
+		require.EqualValues(3, transfers[2].BlockHeight)
+		require.Equal(big.NewInt(7), transfers[2].Amount)
+
+		// pagination
+		transfers, err = indexer.GetTransfersByAddress(addrHash(28), 1, 1)
+		require.NoError(err)
+		require.Len(transfers, 1)
+		require.Equal(big.NewInt(100), transfers[0].Amount)
+		transfers, err = indexer.GetTransfersByAddress(addrHash(28), 2, 10)
+		require.NoError(err)
+		require.Len(transfers, 1)
+		require.Equal(iotextypes.TransactionLogType_CLAIM_FROM_REWARDING_FUND, transfers[0].Type)
+		_, err = indexer.GetTransfersByAddress(addrHash(28), 3, 1)
+		require.Equal(db.ErrInvalid, errors.Cause(err))
+		_, err = indexer.GetTransfersByAddress(addrHash(31), 0, 1)
+		require.Error(err)
+
+		// delete tip blocks
+		require.Equal(db.ErrInvalid, errors.Cause(indexer.DeleteTipBlock(blks[1])))
+		require.NoError(indexer.DeleteTipBlock(blks[2]))
+		for _, c := range []struct {
+			addr  int
+			count uint64
+		}{
+			{0, 1}, {28, 2}, {29, 2}, {30, 1},
+		} {
+			count, err := indexer.GetTransferCountByAddress(addrHash(c.addr))
+			require.NoError(err)
+			require.Equal(c.count, count)
+		}
+		require.NoError(indexer.DeleteTipBlock(blks[1]))
+		require.NoError(indexer.DeleteTipBlock(blks[0]))
+		height, err = indexer.Height()
+		require.NoError(err)
+		require.Zero(height)
+		for _, addr := range []int{0, 28, 29, 30} {
+			count, err := indexer.GetTransferCountByAddress(addrHash(addr))
+			require.NoError(err)
+			require.Zero(count)
+		}
+
+		// index again after revert
+		require.NoError(indexer.PutBlock(ctx, blks[0]))
+		count, err := indexer.GetTransferCountByAddress(addrHash(29))
+		require.NoError(err)
+		require.EqualValues(2, count)
+	}
+
+	t.Run("In-memory KV indexer", func(t *testing.T) {
+		testIndexer(db.NewMemKVStore(), t)
+	})
+	testPath, err := testutil.PathOfTempFile("test-transfer-indexer")
+	require.NoError(err)
+	cfg := config.Default.DB
+	cfg.DbPath = testPath
+	t.Run("Bolt DB indexer", func(t *testing.T) {
+		testutil.CleanupPath(t, testPath)
+		defer testutil.CleanupPath(t, testPath)
+		testIndexer(db.NewBoltDB(cfg), t)
+	})
+}
@@ -101,6 +101,8 @@ func New(
 		indexers           []blockdao.BlockIndexer
 		indexer            blockindex.Indexer
 		bfIndexer          blockindex.BloomFilterIndexer
+		transferIndexer    blockindex.TransferIndexer
+		tokenIndexer       blockindex.TokenIndexer
 		candidateIndexer   *poll.CandidateIndexer
 		candBucketsIndexer *staking.CandidatesBucketsIndexer
 		err                error
@@ -182,6 +184,26 @@ func New(
 		}
 		indexers = append(indexers, bfIndexer)
 
+		// create transfer indexer
+		if cfg.Chain.EnableTransferIndexer {
+			cfg.DB.DbPath = cfg.Chain.TransferIndexDBPath
+			transferIndexer, err = blockindex.NewTransferIndexer(db.NewBoltDB(cfg.DB))
+			if err != nil {
+				return nil, err
+			}
+			indexers = append(indexers, transferIndexer)
+		}
+
+		// create token indexer
+		if cfg.Chain.EnableTokenIndexer {
+			cfg.DB.DbPath = cfg.Chain.TokenIndexDBPath
+			tokenIndexer, err = blockindex.NewTokenIndexer(db.NewBoltDB(cfg.DB))
+			if err != nil {
+				return nil, err
+			}
+			indexers = append(indexers, tokenIndexer)
+		}
+
 		// create candidate indexer
 		cfg.DB.DbPath = cfg.Chain.CandidateIndexDBPath
 		candidateIndexer, err = poll.NewCandidateIndexer(db.NewBoltDB(cfg.DB))
@@ -209,6 +231,10 @@ func New(
 
 	// Create ActPool
 	actOpts := make([]actpool.Option, 0)
+	if cfg.ActPool.JournalPath != "" {
+		cfg.DB.DbPath = cfg.ActPool.JournalPath
+		actOpts = append(actOpts, actpool.EnableJournal(db.NewBoltDB(cfg.DB)))
+	}
 	actPool, err := actpool.NewActPool(sf, cfg.ActPool, actOpts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create actpool")
@@ -217,6 +243,7 @@ func New(
 	// Add action validators
 	actPool.AddActionEnvelopeValidators(
 		protocol.NewGenericValidator(sf, accountutil.AccountState),
+		protocol.NewEncodingValidator(sf, cfg.Genesis),
 	)
 	if !ops.isSubchain {
 		chainOpts = append(chainOpts, blockchain.BlockValidatorOption(block.NewValidator(sf, actPool)))
@@ -360,6 +387,8 @@ func New(
 			return p2pAgent.BroadcastOutbound(ctx, msg)
 		}),
 		api.WithNativeElection(electionCommittee),
+		api.WithTransferIndexer(transferIndexer),
+		api.WithTokenIndexer(tokenIndexer),
 	)
 	if err != nil {
 		return nil, err
@@ -429,6 +458,9 @@ func (cs *ChainService) Start(ctx context.Context) error {
 	if err := cs.chain.Start(ctx); err != nil {
 		return errors.Wrap(err, "error when starting blockchain")
 	}
+	if err := cs.actpool.Start(protocol.WithRegistry(ctx, cs.registry)); err != nil {
+		return errors.Wrap(err, "error when starting actpool")
+	}
 	if err := cs.consensus.Start(ctx); err != nil {
 		return errors.Wrap(err, "error when starting consensus")
 	}
@@ -472,6 +504,9 @@ func (cs *ChainService) Stop(ctx context.Context) error {
 	if err := cs.blocksync.Stop(ctx); err != nil {
 		return errors.Wrap(err, "error when stopping blocksync")
 	}
+	if err := cs.actpool.Stop(ctx); err != nil {
+		return errors.Wrap(err, "error when stopping actpool")
+	}
 	if err := cs.chain.Stop(ctx); err != nil {
 		return errors.Wrap(err, "error when stopping blockchain")
 	}
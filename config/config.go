@@ -55,6 +55,16 @@ const (
 	NOOPScheme = "NOOP"
 )
 
+const (
+	// PricePackingStrategy means that the block producer packs the pending actions by gas price priority
+	PricePackingStrategy = "price"
+	// SimulationPackingStrategy means that the block producer packs the pending actions by the fee per gas after
+	// simulation, and skips and removes the actions which fail in execution
+	SimulationPackingStrategy = "simulation"
+	// FIFOPackingStrategy means that the block producer packs the pending actions in the order of arrival
+	FIFOPackingStrategy = "fifo"
+)
+
 const (
 	// GatewayPlugin is the plugin of accepting user API requests and serving blockchain data to users
 	GatewayPlugin = iota
@@ -109,6 +119,8 @@ var (
 			BloomfilterIndexDBPath: "/var/data/bloomfilter.index.db",
 			CandidateIndexDBPath:   "/var/data/candidate.index.db",
 			StakingIndexDBPath:     "/var/data/staking.index.db",
+			TransferIndexDBPath:    "/var/data/transfer.index.db",
+			TokenIndexDBPath:       "/var/data/token.index.db",
 			ID:                     1,
 			Address:                "",
 			ProducerPrivKey:        generateRandomKey(SigP256k1),
@@ -125,8 +137,11 @@ var (
 			EnableSystemLogIndexer:        false,
 			EnableStakingProtocol:         true,
 			EnableStakingIndexer:          false,
+			EnableTransferIndexer:         false,
+			EnableTokenIndexer:            false,
 			CompressBlock:                 false,
 			AllowedBlockGasResidue:        10000,
+			PackingStrategy:               PricePackingStrategy,
 			MaxCacheSize:                  0,
 			PollInitialCandidatesInterval: 10 * time.Second,
 			StateDBCacheSize:              1000,
@@ -139,6 +154,14 @@ var (
 			ActionExpiry:       10 * time.Minute,
 			MinGasPriceStr:     big.NewInt(unit.Qev).String(),
 			BlackList:          []string{},
+			GasPriceBumpPct:    10,
+			EnableRateLimit:    false,
+			RateLimit: ActPoolRateLimit{
+				AccountAvg:   20,
+				AccountBurst: 200,
+				PeerAvg:      300,
+				PeerBurst:    1000,
+			},
 		},
 		Consensus: Consensus{
 			Scheme: StandaloneScheme,
@@ -173,11 +196,32 @@ var (
 			Port:      14014,
 			TpsWindow: 10,
 			GasStation: GasStation{
-				SuggestBlockWindow: 20,
-				DefaultGas:         uint64(unit.Qev),
-				Percentile:         60,
+				SuggestBlockWindow:       20,
+				DefaultGas:               uint64(unit.Qev),
+				Percentile:               60,
+				ActPoolPressureThreshold: 50,
+				ActPoolPressureBumpPct:   0,
 			},
 			RangeQueryLimit: 1000,
+			GraphQLMaxDepth: 8,
+			RateLimit: APIRateLimit{
+				Enabled:  false,
+				IPAvg:    200,
+				IPBurst:  2000,
+				KeyAvg:   2000,
+				KeyBurst: 20000,
+				APIKeys:  []string{},
+				MethodWeights: map[string]uint64{
+					"EstimateActionGasConsumption": 10,
+					"ReadContract":                 10,
+					"ReadContractAtHeight":         10,
+					"TraceTransaction":             50,
+					"eth_call":                     10,
+					"eth_estimateGas":              10,
+				},
+			},
+			WebsocketQueueSize: 256,
+			Web3BatchLimit:     100,
 		},
 		System: System{
 			Active:                true,
@@ -217,6 +261,7 @@ var (
 		ValidateAPI,
 		ValidateActPool,
 		ValidateForkHeights,
+		ValidatePackingStrategy,
 	}
 )
 
@@ -245,6 +290,8 @@ type (
 		BloomfilterIndexDBPath string           `yaml:"bloomfilterIndexDBPath"`
 		CandidateIndexDBPath   string           `yaml:"candidateIndexDBPath"`
 		StakingIndexDBPath     string           `yaml:"stakingIndexDBPath"`
+		TransferIndexDBPath    string           `yaml:"transferIndexDBPath"`
+		TokenIndexDBPath       string           `yaml:"tokenIndexDBPath"`
 		ID                     uint32           `yaml:"id"`
 		Address                string           `yaml:"address"`
 		ProducerPrivKey        string           `yaml:"producerPrivKey"`
@@ -266,10 +313,17 @@ type (
 		EnableStakingProtocol bool `yaml:"enableStakingProtocol"`
 		// EnableStakingIndexer enables staking indexer
 		EnableStakingIndexer bool `yaml:"enableStakingIndexer"`
+		// EnableTransferIndexer enables the indexer of native token transfers by address, which requires the chain db
+		// to contain transaction logs
+		EnableTransferIndexer bool `yaml:"enableTransferIndexer"`
+		// EnableTokenIndexer enables the indexer of XRC20 and XRC721 token transfers and holders
+		EnableTokenIndexer bool `yaml:"enableTokenIndexer"`
 		// deprecated by DB.CompressBlock
 		CompressBlock bool `yaml:"compressBlock"`
 		// AllowedBlockGasResidue is the amount of gas remained when block producer could stop processing more actions
 		AllowedBlockGasResidue uint64 `yaml:"allowedBlockGasResidue"`
+		// PackingStrategy is the strategy of picking pending actions into new block: price, simulation or fifo
+		PackingStrategy string `yaml:"packingStrategy"`
 		// MaxCacheSize is the max number of blocks that will be put into an LRU cache. 0 means disabled
 		MaxCacheSize int `yaml:"maxCacheSize"`
 		// PollInitialCandidatesInterval is the config for committee init db
@@ -328,9 +382,37 @@ type (
 	API struct {
 		UseRDS          bool       `yaml:"useRDS"`
 		Port            int        `yaml:"port"`
+		Web3Port        int        `yaml:"web3Port"`
+		GraphQLPort     int        `yaml:"graphQLPort"`
+		WebsocketPort   int        `yaml:"websocketPort"`
 		TpsWindow       int        `yaml:"tpsWindow"`
 		GasStation      GasStation `yaml:"gasStation"`
 		RangeQueryLimit uint64     `yaml:"rangeQueryLimit"`
+		// GraphQLMaxDepth is the max nesting depth of a graphql query
+		GraphQLMaxDepth int `yaml:"graphQLMaxDepth"`
+		// RateLimit is the rate limit config of grpc api calls and web3 requests
+		RateLimit APIRateLimit `yaml:"rateLimit"`
+		// WebsocketQueueSize is the max number of messages queued for a websocket client, the client is dropped once
+		// its queue is full
+		WebsocketQueueSize int `yaml:"websocketQueueSize"`
+		// Web3BatchLimit is the max number of requests in a web3 batch request
+		Web3BatchLimit int `yaml:"web3BatchLimit"`
+	}
+
+	// APIRateLimit is the config of api rate limits per client ip and per api key. The numbers are costs per second,
+	// where a call costs the weight of its method, multiplied by the size of the range it queries
+	APIRateLimit struct {
+		Enabled  bool `yaml:"enabled"`
+		IPAvg    int  `yaml:"ipAvg"`
+		IPBurst  int  `yaml:"ipBurst"`
+		KeyAvg   int  `yaml:"keyAvg"`
+		KeyBurst int  `yaml:"keyBurst"`
+		// APIKeys are the keys which clients send in the "x-api-key" metadata or http header to be limited per key
+		// instead of per ip
+		APIKeys []string `yaml:"apiKeys"`
+		// MethodWeights are the weights of grpc methods by name, e.g., "ReadContract", or web3 methods, e.g.,
+		// "eth_call", the weight of other methods is 1
+		MethodWeights map[string]uint64 `yaml:"methodWeights"`
 	}
 
 	// GasStation is the gas station config
@@ -338,6 +420,11 @@ type (
 		SuggestBlockWindow int    `yaml:"suggestBlockWindow"`
 		DefaultGas         uint64 `yaml:"defaultGas"`
 		Percentile         int    `yaml:"Percentile"`
+		// ActPoolPressureThreshold is the percentage of actpool utilization, above which suggested gas price is raised
+		ActPoolPressureThreshold int `yaml:"actPoolPressureThreshold"`
+		// ActPoolPressureBumpPct is the percentage by which suggested gas price is raised when actpool is full, 0 means
+		// the suggested gas price is not raised by the pressure of actpool
+		ActPoolPressureBumpPct int `yaml:"actPoolPressureBumpPct"`
 	}
 
 	// System is the system config
@@ -367,6 +454,23 @@ type (
 		MinGasPriceStr string `yaml:"minGasPrice"`
 		// BlackList lists the account address that are banned from initiating actions
 		BlackList []string `yaml:"blackList"`
+		// GasPriceBumpPct is the minimal percentage by which the gas price of an action has to exceed the gas price
+		// of the pending action with the same sender and nonce in order to replace it
+		GasPriceBumpPct uint64 `yaml:"gasPriceBumpPct"`
+		// JournalPath is the path of the file to persist accepted actions across restarts. Empty path disables journal
+		JournalPath string `yaml:"journalPath"`
+		// EnableRateLimit enables limiting the rate of accepting actions per sender account and per peer
+		EnableRateLimit bool `yaml:"enableRateLimit"`
+		// RateLimit is the rate limit config of actpool
+		RateLimit ActPoolRateLimit `yaml:"rateLimit"`
+	}
+
+	// ActPoolRateLimit is the config of actpool rate limits, all numbers are per second value
+	ActPoolRateLimit struct {
+		AccountAvg   int `yaml:"accountAvg"`
+		AccountBurst int `yaml:"accountBurst"`
+		PeerAvg      int `yaml:"peerAvg"`
+		PeerBurst    int `yaml:"peerBurst"`
 	}
 
 	// DB is the config for database
@@ -621,6 +725,9 @@ func ValidateAPI(cfg Config) error {
 	if cfg.API.TpsWindow <= 0 {
 		return errors.Wrap(ErrInvalidCfg, "tps window is not a positive integer when the api is enabled")
 	}
+	if cfg.API.RateLimit.Enabled && (cfg.API.RateLimit.IPAvg <= 0 || cfg.API.RateLimit.KeyAvg <= 0) {
+		return errors.Wrap(ErrInvalidCfg, "api rate limit per ip or per key should be positive")
+	}
 	return nil
 }
 
@@ -640,9 +747,22 @@ func ValidateActPool(cfg Config) error {
 			"maximum number of actions per pool cannot be less than maximum number of actions per account",
 		)
 	}
+	if cfg.ActPool.EnableRateLimit && (cfg.ActPool.RateLimit.AccountAvg <= 0 || cfg.ActPool.RateLimit.PeerAvg <= 0) {
+		return errors.Wrap(ErrInvalidCfg, "actpool rate limit per account or per peer should be positive")
+	}
 	return nil
 }
 
+// ValidatePackingStrategy validates the block packing strategy
+func ValidatePackingStrategy(cfg Config) error {
+	switch cfg.Chain.PackingStrategy {
+	case PricePackingStrategy, SimulationPackingStrategy, FIFOPackingStrategy:
+		return nil
+	default:
+		return errors.Wrapf(ErrInvalidCfg, "unknown packing strategy %s", cfg.Chain.PackingStrategy)
+	}
+}
+
 // ValidateForkHeights validates the forked heights
 func ValidateForkHeights(cfg Config) error {
 	hu := NewHeightUpgrade(&cfg.Genesis)
@@ -665,6 +785,8 @@ func ValidateForkHeights(cfg Config) error {
 		return errors.Wrap(ErrInvalidCfg, "FairbankMigration is heigher than Fairbank")
 	case hu.FairbankBlockHeight() > hu.GreenlandBlockHeight():
 		return errors.Wrap(ErrInvalidCfg, "Fairbank is heigher than Greenland")
+	case hu.HawaiiBlockHeight() > hu.IcelandBlockHeight():
+		return errors.Wrap(ErrInvalidCfg, "Hawaii is heigher than Iceland")
 	}
 	return nil
 }
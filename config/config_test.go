@@ -253,6 +253,23 @@ func TestValidateArchiveMode(t *testing.T) {
 	require.NoError(t, errors.Cause(ValidateArchiveMode(cfg)))
 }
 
+func TestValidateAPI(t *testing.T) {
+	cfg := Default
+	require.NoError(t, ValidateAPI(cfg))
+	cfg.API.TpsWindow = 0
+	err := ValidateAPI(cfg)
+	require.Error(t, err)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+
+	cfg.API.TpsWindow = 10
+	cfg.API.RateLimit.Enabled = true
+	cfg.API.RateLimit.KeyAvg = 0
+	err = ValidateAPI(cfg)
+	require.Error(t, err)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.True(t, strings.Contains(err.Error(), "api rate limit per ip or per key should be positive"))
+}
+
 func TestValidateActPool(t *testing.T) {
 	cfg := Default
 	cfg.ActPool.MaxNumActsPerAcct = 0
@@ -291,6 +308,20 @@ func TestValidateActPool(t *testing.T) {
 			"maximum number of actions per pool cannot be less than maximum number of actions per account",
 		),
 	)
+
+	cfg.ActPool.MaxNumActsPerPool = 100
+	cfg.ActPool.EnableRateLimit = true
+	cfg.ActPool.RateLimit.PeerAvg = 0
+	err = ValidateActPool(cfg)
+	require.Error(t, err)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.True(
+		t,
+		strings.Contains(
+			err.Error(),
+			"actpool rate limit per account or per peer should be positive",
+		),
+	)
 }
 
 func TestValidateMinGasPrice(t *testing.T) {
@@ -312,6 +343,18 @@ func TestValidateProducerAddress(t *testing.T) {
 	require.NotNil(t, addr)
 }
 
+func TestValidatePackingStrategy(t *testing.T) {
+	cfg := Default
+	require.NoError(t, ValidatePackingStrategy(cfg))
+	cfg.Chain.PackingStrategy = FIFOPackingStrategy
+	require.NoError(t, ValidatePackingStrategy(cfg))
+	cfg.Chain.PackingStrategy = "random"
+	err := ValidatePackingStrategy(cfg)
+	require.Error(t, err)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.True(t, strings.Contains(err.Error(), "unknown packing strategy random"))
+}
+
 func TestValidateForkHeights(t *testing.T) {
 	r := require.New(t)
 
@@ -347,6 +390,9 @@ func TestValidateForkHeights(t *testing.T) {
 		{
 			"Fairbank", ErrInvalidCfg, "Fairbank is heigher than Greenland",
 		},
+		{
+			"Hawaii", ErrInvalidCfg, "Hawaii is heigher than Iceland",
+		},
 		{
 			"", nil, "",
 		},
@@ -383,6 +429,8 @@ func newTestCfg(fork string) Config {
 		cfg.Genesis.FbkMigrationBlockHeight = cfg.Genesis.FairbankBlockHeight + 1
 	case "Fairbank":
 		cfg.Genesis.FairbankBlockHeight = cfg.Genesis.GreenlandBlockHeight + 1
+	case "Hawaii":
+		cfg.Genesis.HawaiiBlockHeight = cfg.Genesis.IcelandBlockHeight + 1
 	}
 	return cfg
 }
@@ -8,6 +8,7 @@ package crypto
 
 import (
 	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
 )
 
 // Merkle tree struct
@@ -79,3 +80,51 @@ func (mk *Merkle) HashTree() hash.Hash256 {
 	mk.root = merkle[0]
 	return mk.root
 }
+
+// Proof returns the merkle proof of the leaf at index, which is the sibling hashes on the path from the leaf to the
+// root, from bottom to top
+func (mk *Merkle) Proof(index int) ([]hash.Hash256, error) {
+	if index < 0 || index >= mk.size {
+		return nil, errors.Errorf("index %d is out of range [0, %d)", index, mk.size)
+	}
+
+	level := make([]hash.Hash256, mk.size)
+	copy(level, mk.leaf)
+	proof := []hash.Hash256{}
+	for len(level) > 1 {
+		// copy the last hash if the number of hashes is odd, same as HashTree
+		if len(level)&1 != 0 {
+			level = append(level, level[len(level)-1])
+		}
+		proof = append(proof, level[index^1])
+
+		length := len(level) >> 1
+		for i := 0; i < length; i++ {
+			h := level[i<<1][:]
+			h = append(h, level[i<<1+1][:]...)
+			level[i] = hash.Hash256b(h)
+		}
+		level = level[0:length]
+		index >>= 1
+	}
+	return proof, nil
+}
+
+// VerifyMerkleProof verifies the merkle proof of the leaf at index against the root hash
+func VerifyMerkleProof(root hash.Hash256, leaf hash.Hash256, index int, proof []hash.Hash256) bool {
+	if index < 0 {
+		return false
+	}
+	h := leaf
+	for _, sibling := range proof {
+		var b []byte
+		if index&1 == 0 {
+			b = append(h[:], sibling[:]...)
+		} else {
+			b = append(sibling[:], h[:]...)
+		}
+		h = hash.Hash256b(b)
+		index >>= 1
+	}
+	return index == 0 && h == root
+}
@@ -41,3 +41,32 @@ func TestMerkleTree(t *testing.T) {
 	rootHashHex := hex.EncodeToString(rootHash[:])
 	assert.Equal(t, "4de26a6d1d6618f7bfeb3d168e37ef645db94c2d558bf8c3546d1311877ddffa", rootHashHex)
 }
+
+func TestMerkleProof(t *testing.T) {
+	for n := 1; n <= 9; n++ {
+		leaves := make([]hash.Hash256, n)
+		for i := range leaves {
+			leaves[i] = hash.Hash256b([]byte{byte(i)})
+		}
+		m := NewMerkleTree(leaves)
+		root := m.HashTree()
+		for i, leaf := range leaves {
+			proof, err := m.Proof(i)
+			assert.NoError(t, err)
+			assert.True(t, VerifyMerkleProof(root, leaf, i, proof))
+			// wrong index, leaf or root
+			assert.False(t, VerifyMerkleProof(root, leaf, i+len(leaves)+1, proof))
+			assert.False(t, VerifyMerkleProof(root, hash.Hash256b([]byte("leaf")), i, proof))
+			assert.False(t, VerifyMerkleProof(hash.ZeroHash256, leaf, i, proof))
+			if n > 1 {
+				assert.False(t, VerifyMerkleProof(root, leaf, i, proof[:len(proof)-1]))
+			}
+		}
+		// the root is not changed by the proofs
+		assert.Equal(t, root, NewMerkleTree(leaves).HashTree())
+		_, err := m.Proof(-1)
+		assert.Error(t, err)
+		_, err = m.Proof(len(leaves) + 1)
+		assert.Error(t, err)
+	}
+}
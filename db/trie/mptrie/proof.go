@@ -0,0 +1,149 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package mptrie
+
+import (
+	"bytes"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/db/trie"
+	"github.com/iotexproject/iotex-core/db/trie/triepb"
+)
+
+// ErrInvalidProof indicates the proof does not match the root hash or the key
+var ErrInvalidProof = errors.New("invalid proof")
+
+// GetProof returns the serialized nodes on the path from the root to the key. If the key does not exist, the path ends
+// at the node where the search stops, which proves the absence of the key
+func (mpt *merklePatriciaTrie) GetProof(key []byte) ([][]byte, error) {
+	mpt.mutex.RLock()
+	defer mpt.mutex.RUnlock()
+
+	trieMtc.WithLabelValues("root", "GetProof").Inc()
+	kt, err := mpt.checkKeyType(key)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		proof  [][]byte
+		n      node = mpt.root
+		offset uint8
+	)
+	for {
+		if hn, ok := n.(*hashNode); ok {
+			if n, err = hn.LoadNode(); err != nil {
+				return nil, err
+			}
+		}
+		sn, ok := n.(serializable)
+		if !ok {
+			return nil, errors.Wrapf(trie.ErrInvalidTrie, "unexpected node type %T", n)
+		}
+		pb, err := sn.proto(false)
+		if err != nil {
+			return nil, err
+		}
+		ser, err := proto.Marshal(pb)
+		if err != nil {
+			return nil, err
+		}
+		proof = append(proof, ser)
+		switch node := n.(type) {
+		case *branchNode:
+			child, err := node.child(kt[offset])
+			if errors.Cause(err) == trie.ErrNotExist {
+				return proof, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			n = child
+			offset++
+		case *extensionNode:
+			matched := node.commonPrefixLength(kt[offset:])
+			if matched != uint8(len(node.path)) {
+				return proof, nil
+			}
+			n = node.child
+			offset += matched
+		case *leafNode:
+			return proof, nil
+		default:
+			return nil, errors.Wrapf(trie.ErrInvalidTrie, "unexpected node type %T", n)
+		}
+	}
+}
+
+// VerifyProof verifies the proof of the key against the root hash of a trie built with the hash func, and returns the
+// value of the key. trie.ErrNotExist is returned if the proof shows the key does not exist in the trie
+func VerifyProof(rootHash []byte, key []byte, proof [][]byte, hashFunc HashFunc) ([]byte, error) {
+	expected := rootHash
+	offset := 0
+	for i, ser := range proof {
+		if !bytes.Equal(hashFunc(ser), expected) {
+			return nil, errors.Wrapf(ErrInvalidProof, "hash of node %d does not match", i)
+		}
+		last := i == len(proof)-1
+		pb := triepb.NodePb{}
+		if err := proto.Unmarshal(ser, &pb); err != nil {
+			return nil, errors.Wrapf(ErrInvalidProof, "failed to unmarshal node %d", i)
+		}
+		switch {
+		case pb.GetBranch() != nil:
+			if offset >= len(key) {
+				return nil, errors.Wrapf(ErrInvalidProof, "branch node %d is beyond the key", i)
+			}
+			expected = nil
+			for _, child := range pb.GetBranch().GetBranches() {
+				if child.GetIndex() == uint32(key[offset]) {
+					expected = child.GetPath()
+					break
+				}
+			}
+			if expected == nil {
+				if !last {
+					return nil, errors.Wrapf(ErrInvalidProof, "redundant nodes after node %d", i)
+				}
+				return nil, trie.ErrNotExist
+			}
+			offset++
+		case pb.GetExtend() != nil:
+			path := pb.GetExtend().GetPath()
+			if offset+len(path) > len(key) || !bytes.Equal(path, key[offset:offset+len(path)]) {
+				if !last {
+					return nil, errors.Wrapf(ErrInvalidProof, "redundant nodes after node %d", i)
+				}
+				return nil, trie.ErrNotExist
+			}
+			expected = pb.GetExtend().GetValue()
+			offset += len(path)
+		case pb.GetLeaf() != nil:
+			if !last {
+				return nil, errors.Wrapf(ErrInvalidProof, "redundant nodes after node %d", i)
+			}
+			if !bytes.Equal(pb.GetLeaf().GetPath(), key) {
+				return nil, trie.ErrNotExist
+			}
+			return pb.GetLeaf().GetValue(), nil
+		default:
+			return nil, errors.Wrapf(ErrInvalidProof, "invalid type of node %d", i)
+		}
+	}
+	return nil, errors.Wrap(ErrInvalidProof, "the proof ends before reaching the key")
+}
+
+// VerifyTwoLayerProof verifies the proofs of a two layer trie against the root hash of layer one, and returns the value
+// of the key in layer two. The value of the layer one key is the root hash of layer two
+func VerifyTwoLayerProof(rootHash []byte, layerOneKey []byte, layerTwoKey []byte, layerOneProof [][]byte, layerTwoProof [][]byte) ([]byte, error) {
+	layerTwoRoot, err := VerifyProof(rootHash, layerOneKey, layerOneProof, DefaultHashFunc)
+	if err != nil {
+		return nil, err
+	}
+	return VerifyProof(layerTwoRoot, layerTwoKey, layerTwoProof, DefaultHashFunc)
+}
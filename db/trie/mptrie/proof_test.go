@@ -0,0 +1,92 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package mptrie
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/db/trie"
+)
+
+func TestProof(t *testing.T) {
+	for _, async := range []bool{false, true} {
+		require := require.New(t)
+		opts := []Option{KeyLengthOption(8)}
+		if async {
+			opts = append(opts, AsyncOption())
+		}
+		tr, err := New(opts...)
+		require.NoError(err)
+		require.NoError(tr.Start(context.Background()))
+
+		// proof of empty trie
+		root, err := tr.RootHash()
+		require.NoError(err)
+		proof, err := tr.GetProof(cat)
+		require.NoError(err)
+		require.Len(proof, 1)
+		_, err = VerifyProof(root, cat, proof, DefaultHashFunc)
+		require.Equal(trie.ErrNotExist, errors.Cause(err))
+
+		keys := [][]byte{cat, rat, car, egg, dog, ham, fox, cow, ant}
+		for _, k := range keys {
+			require.NoError(tr.Upsert(k, testV[k[7]%8]))
+		}
+		root, err = tr.RootHash()
+		require.NoError(err)
+		for _, k := range keys {
+			proof, err := tr.GetProof(k)
+			require.NoError(err)
+			value, err := VerifyProof(root, k, proof, DefaultHashFunc)
+			require.NoError(err)
+			require.Equal(testV[k[7]%8], value)
+			// the proof does not prove another key
+			_, err = VerifyProof(root, br1, proof, DefaultHashFunc)
+			require.Error(err)
+		}
+		// proof of absence
+		for _, k := range [][]byte{br1, cl2, {1, 2, 3, 4, 5, 6, 7, 0}, {1, 2, 3, 4, 6, 0, 0, 0}, {1, 2, 5, 6, 0, 0, 0, 0}} {
+			proof, err := tr.GetProof(k)
+			require.NoError(err)
+			_, err = VerifyProof(root, k, proof, DefaultHashFunc)
+			require.Equal(trie.ErrNotExist, errors.Cause(err))
+		}
+
+		proof, err = tr.GetProof(dog)
+		require.NoError(err)
+		require.True(len(proof) > 1)
+		// wrong root
+		_, err = VerifyProof(hash.ZeroHash256[:], dog, proof, DefaultHashFunc)
+		require.Equal(ErrInvalidProof, errors.Cause(err))
+		// wrong hash func
+		_, err = VerifyProof(root, dog, proof, func(data []byte) []byte {
+			h := hash.Hash256b(data)
+			return h[:]
+		})
+		require.Equal(ErrInvalidProof, errors.Cause(err))
+		// tampered node
+		tampered := make([][]byte, len(proof))
+		copy(tampered, proof)
+		tampered[len(tampered)-1] = append([]byte{}, proof[len(proof)-1]...)
+		tampered[len(tampered)-1][len(tampered[len(tampered)-1])-1]++
+		_, err = VerifyProof(root, dog, tampered, DefaultHashFunc)
+		require.Equal(ErrInvalidProof, errors.Cause(err))
+		// incomplete proof
+		_, err = VerifyProof(root, dog, proof[:len(proof)-1], DefaultHashFunc)
+		require.Equal(ErrInvalidProof, errors.Cause(err))
+		// invalid key
+		_, err = tr.GetProof([]byte("short"))
+		require.Error(err)
+
+		require.NoError(tr.Stop(context.Background()))
+	}
+}
@@ -164,6 +164,29 @@ func (tlt *twoLayerTrie) Get(layerOneKey []byte, layerTwoKey []byte) ([]byte, er
 	return lt.tr.Get(layerTwoKey)
 }
 
+// GetProof returns the proof of the layer one key in layer one, whose value is the root of layer two, and the proof
+// of the layer two key in layer two
+func (tlt *twoLayerTrie) GetProof(layerOneKey []byte, layerTwoKey []byte) ([][]byte, [][]byte, error) {
+	// flush the dirty layer two tries, such that layer one has the latest roots of them
+	if err := tlt.flush(context.Background()); err != nil {
+		return nil, nil, err
+	}
+	layerOneProof, err := tlt.layerOne.GetProof(layerOneKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	lt, err := tlt.layerTwoTrie(layerOneKey, len(layerTwoKey))
+	if err != nil {
+		return nil, nil, err
+	}
+	layerTwoProof, err := lt.tr.GetProof(layerTwoKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return layerOneProof, layerTwoProof, nil
+}
+
 func (tlt *twoLayerTrie) Upsert(layerOneKey []byte, layerTwoKey []byte, value []byte) error {
 	lt, err := tlt.layerTwoTrie(layerOneKey, len(layerTwoKey))
 	if err != nil {
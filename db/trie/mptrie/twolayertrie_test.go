@@ -10,6 +10,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotexproject/iotex-core/db/trie"
@@ -33,3 +34,44 @@ func TestTwoLayerTrie(t *testing.T) {
 	value, err = tlt.Get([]byte("layerOneKey111111111"), []byte("layerTwoKey1"))
 	require.Error(t, err)
 }
+
+func TestTwoLayerTrieProof(t *testing.T) {
+	require := require.New(t)
+	tlt := NewTwoLayerTrie(trie.NewMemKVStore(), "rootKey")
+	require.NoError(tlt.Start(context.Background()))
+	defer require.NoError(tlt.Stop(context.Background()))
+
+	layerOneKey, missingLayerOneKey := []byte("layerOneKey111111111"), []byte("layerOneKey222222222")
+	require.NoError(tlt.Upsert(layerOneKey, []byte("layerTwoKey1"), []byte("value1")))
+	require.NoError(tlt.Upsert(layerOneKey, []byte("layerTwoKey2"), []byte("value2")))
+	root, err := tlt.RootHash()
+	require.NoError(err)
+
+	p1, p2, err := tlt.GetProof(layerOneKey, []byte("layerTwoKey1"))
+	require.NoError(err)
+	value, err := VerifyTwoLayerProof(root, layerOneKey, []byte("layerTwoKey1"), p1, p2)
+	require.NoError(err)
+	require.Equal([]byte("value1"), value)
+	// the layer two proof of another key
+	_, err = VerifyTwoLayerProof(root, layerOneKey, []byte("layerTwoKey2"), p1, p2)
+	require.Error(err)
+
+	p1, p2, err = tlt.GetProof(layerOneKey, []byte("layerTwoKey3"))
+	require.NoError(err)
+	_, err = VerifyTwoLayerProof(root, layerOneKey, []byte("layerTwoKey3"), p1, p2)
+	require.Equal(trie.ErrNotExist, errors.Cause(err))
+	p1, p2, err = tlt.GetProof(missingLayerOneKey, []byte("layerTwoKey1"))
+	require.NoError(err)
+	_, err = VerifyTwoLayerProof(root, missingLayerOneKey, []byte("layerTwoKey1"), p1, p2)
+	require.Equal(trie.ErrNotExist, errors.Cause(err))
+
+	// proof of the dirty layer two trie
+	require.NoError(tlt.Upsert(layerOneKey, []byte("layerTwoKey1"), []byte("value3")))
+	p1, p2, err = tlt.GetProof(layerOneKey, []byte("layerTwoKey1"))
+	require.NoError(err)
+	root, err = tlt.RootHash()
+	require.NoError(err)
+	value, err = VerifyTwoLayerProof(root, layerOneKey, []byte("layerTwoKey1"), p1, p2)
+	require.NoError(err)
+	require.Equal([]byte("value3"), value)
+}
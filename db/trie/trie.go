@@ -39,6 +39,8 @@ type (
 		Upsert([]byte, []byte) error
 		// Get retrieves an existing entry
 		Get([]byte) ([]byte, error)
+		// GetProof returns the serialized nodes on the path to an entry
+		GetProof([]byte) ([][]byte, error)
 		// Delete deletes an entry
 		Delete([]byte) error
 		// RootHash returns trie's root hash
@@ -60,6 +62,8 @@ type (
 		SetRootHash([]byte) error
 		// Get returns the value in layer two
 		Get([]byte, []byte) ([]byte, error)
+		// GetProof returns the proofs of an item in layer one and layer two
+		GetProof([]byte, []byte) ([][]byte, [][]byte, error)
 		// Upsert upserts an item in layer two
 		Upsert([]byte, []byte, []byte) error
 		// Delete deletes an item in layer two
@@ -13,11 +13,13 @@ import (
 	"sync/atomic"
 
 	"github.com/golang/protobuf/proto"
+	p2p "github.com/iotexproject/go-p2p"
 	peerstore "github.com/libp2p/go-libp2p-peerstore"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 
+	"github.com/iotexproject/iotex-core/actpool"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/pkg/log"
@@ -222,7 +224,12 @@ func (d *IotxDispatcher) handleActionMsg(m *actionMsg) {
 	d.subscribersMU.RUnlock()
 	if ok {
 		d.updateEventAudit(iotexrpc.MessageType_ACTION)
-		if err := subscriber.HandleAction(m.ctx, m.action); err != nil {
+		ctx := m.ctx
+		if rawmsg, ok := p2p.GetBroadcastMsg(ctx); ok {
+			// pass the originating peer to actpool for rate limiting
+			ctx = actpool.WithPeer(ctx, rawmsg.GetFrom().Pretty())
+		}
+		if err := subscriber.HandleAction(ctx, m.action); err != nil {
 			requestMtc.WithLabelValues("AddAction", "false").Inc()
 			log.L().Debug("Handle action request error.", zap.Error(err))
 		}
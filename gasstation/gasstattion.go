@@ -10,6 +10,9 @@ import (
 	"context"
 	"math/big"
 	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
 
 	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-address/address"
@@ -22,31 +25,119 @@ import (
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 )
 
+// ErrInvalidFeeHistoryQuery indicates the fee history query has invalid arguments
+var ErrInvalidFeeHistoryQuery = errors.New("invalid fee history query")
+
 // BlockDAO represents the block data access object
 type BlockDAO interface {
 	GetBlockHash(uint64) (hash.Hash256, error)
 	GetBlockByHeight(uint64) (*block.Block, error)
+	GetReceipts(uint64) ([]*action.Receipt, error)
+}
+
+// ActPool represents the pending action pool, whose utilization is taken as the pressure on gas price
+type ActPool interface {
+	GetSize() uint64
+	GetCapacity() uint64
 }
 
 // SimulateFunc is function that simulate execution
 type SimulateFunc func(context.Context, address.Address, *action.Execution, evm.GetBlockHash) ([]byte, *action.Receipt, error)
 
+// Option is the option to create gas station
+type Option func(gs *GasStation)
+
+// WithActPool is the option to raise suggested gas price by the pressure of pending actions
+func WithActPool(ap ActPool) Option {
+	return func(gs *GasStation) {
+		gs.ap = ap
+	}
+}
+
+// FeeHistory is the gas fee history of a range of blocks
+type FeeHistory struct {
+	// OldestBlock is the height of the first block in the range
+	OldestBlock uint64
+	// GasUsedRatio is the ratio of gas consumed to block gas limit of each block
+	GasUsedRatio []float64
+	// Reward is the gas prices at the requested percentiles of each block, weighted by gas consumed of actions.
+	// The gas prices are zero for a block without user actions.
+	Reward [][]*big.Int
+}
+
+// priceSample is the smallest gas price of user actions in a block, nil if there is no user action
+type priceSample struct {
+	height uint64
+	price  *big.Int
+}
+
 // GasStation provide gas related api
 type GasStation struct {
 	bc        blockchain.Blockchain
 	simulator SimulateFunc
 	dao       BlockDAO
+	ap        ActPool
 	cfg       config.API
+
+	mutex sync.RWMutex
+	// samples is the rolling window of price samples of the latest SuggestBlockWindow blocks
+	samples []priceSample
+	// height is the height of the latest block in window
+	height uint64
+	// suggested is the gas price suggested by the samples in window
+	suggested uint64
 }
 
 // NewGasStation creates a new gas station
-func NewGasStation(bc blockchain.Blockchain, simulator SimulateFunc, dao BlockDAO, cfg config.API) *GasStation {
-	return &GasStation{
+func NewGasStation(bc blockchain.Blockchain, simulator SimulateFunc, dao BlockDAO, cfg config.API, opts ...Option) *GasStation {
+	gs := &GasStation{
 		bc:        bc,
 		simulator: simulator,
 		dao:       dao,
 		cfg:       cfg,
+		suggested: cfg.GasStation.DefaultGas,
+	}
+	for _, opt := range opts {
+		opt(gs)
+	}
+	return gs
+}
+
+// ReceiveBlock updates the price samples with the new block
+func (gs *GasStation) ReceiveBlock(blk *block.Block) error {
+	height := blk.Height()
+	if err := gs.catchUp(height - 1); err != nil {
+		return err
+	}
+	gs.mutex.Lock()
+	defer gs.mutex.Unlock()
+	if height <= gs.height {
+		// the block has been read from dao when catching up with the tip
+		return nil
+	}
+	gs.addSample(blk)
+	gs.updateSuggestion()
+	return nil
+}
+
+// RevertBlock drops the price samples of the reverted block and the blocks above it, and updates the suggested gas
+// price by the remaining samples. The window is not refilled with the blocks below it, but by the following new blocks
+func (gs *GasStation) RevertBlock(blk *block.Block) error {
+	height := blk.Height()
+	gs.mutex.Lock()
+	defer gs.mutex.Unlock()
+	if height > gs.height {
+		// the block has not been added into window
+		return nil
 	}
+	i := len(gs.samples)
+	for i > 0 && gs.samples[i-1].height >= height {
+		i--
+	}
+	gs.samples = gs.samples[:i]
+	gs.height = height - 1
+	gs.updateSuggestion()
+	return nil
 }
 
 //IsSystemAction determine whether input action belongs to system action
@@ -63,47 +154,229 @@ func (gs *GasStation) IsSystemAction(act action.SealedEnvelope) bool {
 
 // SuggestGasPrice suggest gas price
 func (gs *GasStation) SuggestGasPrice() (uint64, error) {
-	var smallestPrices []*big.Int
-	tip := gs.bc.TipHeight()
-
-	endBlockHeight := uint64(0)
-	if tip > uint64(gs.cfg.GasStation.SuggestBlockWindow) {
-		endBlockHeight = tip - uint64(gs.cfg.GasStation.SuggestBlockWindow)
+	if err := gs.catchUp(gs.bc.TipHeight()); err != nil {
+		return gs.cfg.GasStation.DefaultGas, err
 	}
+	gs.mutex.RLock()
+	gasPrice := gs.suggested
+	gs.mutex.RUnlock()
 
-	for height := tip; height > endBlockHeight; height-- {
-		blk, err := gs.dao.GetBlockByHeight(height)
+	return gs.applyPoolPressure(gasPrice), nil
+}
+
+// catchUp reads the blocks missing in window up to the given height from dao, and adds them into window. The blocks are
+// read without holding the lock, so that the readers of suggested gas price are not blocked by dao
+func (gs *GasStation) catchUp(height uint64) error {
+	gs.mutex.RLock()
+	start := gs.height + 1
+	gs.mutex.RUnlock()
+	if height < start {
+		return nil
+	}
+	window := uint64(gs.cfg.GasStation.SuggestBlockWindow)
+	if height > window && height-window+1 > start {
+		start = height - window + 1
+	}
+	blks := make([]*block.Block, 0, height-start+1)
+	for h := start; h <= height; h++ {
+		blk, err := gs.dao.GetBlockByHeight(h)
 		if err != nil {
-			return gs.cfg.GasStation.DefaultGas, err
+			return err
 		}
-		if len(blk.Actions) == 0 {
-			continue
+		blks = append(blks, blk)
+	}
+
+	gs.mutex.Lock()
+	defer gs.mutex.Unlock()
+	for _, blk := range blks {
+		// skip the blocks added by others in the meantime
+		if blk.Height() > gs.height {
+			gs.addSample(blk)
 		}
-		if len(blk.Actions) == 1 && gs.IsSystemAction(blk.Actions[0]) {
+	}
+	gs.updateSuggestion()
+	return nil
+}
+
+// addSample appends the price sample of the block to window, and drops the samples out of window
+func (gs *GasStation) addSample(blk *block.Block) {
+	gs.height = blk.Height()
+	gs.samples = append(gs.samples, priceSample{
+		height: gs.height,
+		price:  gs.smallestGasPrice(blk),
+	})
+	window := uint64(gs.cfg.GasStation.SuggestBlockWindow)
+	i := 0
+	for i < len(gs.samples) && gs.samples[i].height+window <= gs.height {
+		i++
+	}
+	gs.samples = gs.samples[i:]
+}
+
+// smallestGasPrice returns the smallest gas price of user actions in the block
+func (gs *GasStation) smallestGasPrice(blk *block.Block) *big.Int {
+	if len(blk.Actions) == 0 {
+		return nil
+	}
+	if len(blk.Actions) == 1 && gs.IsSystemAction(blk.Actions[0]) {
+		return nil
+	}
+	smallestPrice := blk.Actions[0].GasPrice()
+	for _, act := range blk.Actions {
+		if gs.IsSystemAction(act) {
 			continue
 		}
-		smallestPrice := blk.Actions[0].GasPrice()
-		for _, act := range blk.Actions {
-			if gs.IsSystemAction(act) {
-				continue
-			}
-			if smallestPrice.Cmp(act.GasPrice()) == 1 {
-				smallestPrice = act.GasPrice()
-			}
+		if smallestPrice.Cmp(act.GasPrice()) == 1 {
+			smallestPrice = act.GasPrice()
 		}
-		smallestPrices = append(smallestPrices, smallestPrice)
 	}
+	return smallestPrice
+}
 
+// updateSuggestion updates the suggested gas price by the samples in window
+func (gs *GasStation) updateSuggestion() {
+	var smallestPrices []*big.Int
+	for _, sample := range gs.samples {
+		if sample.price != nil {
+			smallestPrices = append(smallestPrices, sample.price)
+		}
+	}
 	if len(smallestPrices) == 0 {
 		// return default price
-		return gs.cfg.GasStation.DefaultGas, nil
+		gs.suggested = gs.cfg.GasStation.DefaultGas
+		return
 	}
 	sort.Sort(bigIntArray(smallestPrices))
 	gasPrice := smallestPrices[(len(smallestPrices)-1)*gs.cfg.GasStation.Percentile/100].Uint64()
 	if gasPrice < gs.cfg.GasStation.DefaultGas {
 		gasPrice = gs.cfg.GasStation.DefaultGas
 	}
-	return gasPrice, nil
+	gs.suggested = gasPrice
+}
+
+// applyPoolPressure raises the gas price linearly by the utilization of actpool above the threshold, up to
+// ActPoolPressureBumpPct percent when actpool is full
+func (gs *GasStation) applyPoolPressure(gasPrice uint64) uint64 {
+	if gs.ap == nil {
+		return gasPrice
+	}
+	capacity := gs.ap.GetCapacity()
+	threshold := uint64(gs.cfg.GasStation.ActPoolPressureThreshold)
+	if capacity == 0 || threshold >= 100 {
+		return gasPrice
+	}
+	utilization := gs.ap.GetSize() * 100 / capacity
+	if utilization > 100 {
+		utilization = 100
+	}
+	if utilization <= threshold {
+		return gasPrice
+	}
+	bumpPct := (utilization - threshold) * uint64(gs.cfg.GasStation.ActPoolPressureBumpPct) / (100 - threshold)
+	return gasPrice + gasPrice*bumpPct/100
+}
+
+// FeeHistory returns the fee history of blockCount blocks ending at newestBlock, or ending at the tip block if
+// newestBlock is 0. The number of blocks is capped by the range query limit.
+func (gs *GasStation) FeeHistory(blockCount, newestBlock uint64, percentiles []float64) (*FeeHistory, error) {
+	if blockCount == 0 {
+		return nil, errors.Wrap(ErrInvalidFeeHistoryQuery, "block count should be greater than 0")
+	}
+	for i, p := range percentiles {
+		if p < 0 || p > 100 {
+			return nil, errors.Wrapf(ErrInvalidFeeHistoryQuery, "percentile %f is out of range [0, 100]", p)
+		}
+		if i > 0 && p < percentiles[i-1] {
+			return nil, errors.Wrap(ErrInvalidFeeHistoryQuery, "percentiles should be in ascending order")
+		}
+	}
+	tip := gs.bc.TipHeight()
+	if newestBlock == 0 {
+		newestBlock = tip
+	}
+	if newestBlock > tip {
+		return nil, errors.Wrapf(ErrInvalidFeeHistoryQuery, "newest block %d is higher than tip height %d", newestBlock, tip)
+	}
+	if blockCount > gs.cfg.RangeQueryLimit {
+		blockCount = gs.cfg.RangeQueryLimit
+	}
+	if blockCount > newestBlock {
+		blockCount = newestBlock
+	}
+
+	history := &FeeHistory{
+		OldestBlock:  newestBlock - blockCount + 1,
+		GasUsedRatio: make([]float64, 0, blockCount),
+		Reward:       make([][]*big.Int, 0, blockCount),
+	}
+	gasLimit := gs.bc.Genesis().BlockGasLimit
+	for height := history.OldestBlock; height <= newestBlock; height++ {
+		blk, err := gs.dao.GetBlockByHeight(height)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get block %d", height)
+		}
+		receipts, err := gs.dao.GetReceipts(height)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get receipts of block %d", height)
+		}
+		ratio, reward := gs.blockFeeStats(blk, receipts, gasLimit, percentiles)
+		history.GasUsedRatio = append(history.GasUsedRatio, ratio)
+		history.Reward = append(history.Reward, reward)
+	}
+	return history, nil
+}
+
+// blockFeeStats returns the gas used ratio of the block, and the gas prices at the percentiles of gas consumed by
+// user actions in the block
+func (gs *GasStation) blockFeeStats(
+	blk *block.Block,
+	receipts []*action.Receipt,
+	gasLimit uint64,
+	percentiles []float64,
+) (float64, []*big.Int) {
+	gasConsumed := make(map[hash.Hash256]uint64, len(receipts))
+	var gasUsed uint64
+	for _, r := range receipts {
+		gasConsumed[r.ActionHash] = r.GasConsumed
+		gasUsed += r.GasConsumed
+	}
+	ratio := float64(0)
+	if gasLimit > 0 {
+		ratio = float64(gasUsed) / float64(gasLimit)
+	}
+
+	reward := make([]*big.Int, len(percentiles))
+	var (
+		samples  []gasSample
+		totalGas uint64
+	)
+	for _, act := range blk.Actions {
+		if gs.IsSystemAction(act) {
+			continue
+		}
+		gas := gasConsumed[act.Hash()]
+		samples = append(samples, gasSample{price: act.GasPrice(), gas: gas})
+		totalGas += gas
+	}
+	if len(samples) == 0 {
+		for i := range reward {
+			reward[i] = big.NewInt(0)
+		}
+		return ratio, reward
+	}
+	sort.Slice(samples, func(i, j int) bool {
+		return samples[i].price.Cmp(samples[j].price) < 0
+	})
+	idx, sum := 0, samples[0].gas
+	for i, p := range percentiles {
+		threshold := float64(totalGas) * p / 100
+		for float64(sum) < threshold && idx < len(samples)-1 {
+			idx++
+			sum += samples[idx].gas
+		}
+		reward[i] = new(big.Int).Set(samples[idx].price)
+	}
+	return ratio, reward
 }
 
 // EstimateGasForAction estimate gas for action
@@ -135,6 +408,11 @@ func (gs *GasStation) EstimateGasForAction(actPb *iotextypes.Action) (uint64, er
 	return gas, nil
 }
 
+type gasSample struct {
+	price *big.Int
+	gas   uint64
+}
+
 type bigIntArray []*big.Int
 
 func (s bigIntArray) Len() int           { return len(s) }
@@ -15,8 +15,12 @@ import (
 	"github.com/iotexproject/iotex-core/actpool"
 	"github.com/iotexproject/iotex-core/pkg/unit"
 
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
+	"github.com/iotexproject/go-pkgs/hash"
+
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
 	"github.com/iotexproject/iotex-core/action/protocol/account"
@@ -27,10 +31,13 @@ import (
 	"github.com/iotexproject/iotex-core/blockchain"
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/blockchain/blockdao"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/pkg/version"
 	"github.com/iotexproject/iotex-core/state/factory"
 	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_actpool"
+	"github.com/iotexproject/iotex-core/test/mock/mock_blockchain"
 	"github.com/iotexproject/iotex-core/testutil"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 )
@@ -173,6 +180,228 @@ func TestSuggestGasPriceForSystemAction(t *testing.T) {
 	require.Equal(t, gs.cfg.GasStation.DefaultGas, gp)
 }
 
+type testBlockDAO struct {
+	blocks   map[uint64]*block.Block
+	receipts map[uint64][]*action.Receipt
+}
+
+func (dao *testBlockDAO) GetBlockHash(uint64) (hash.Hash256, error) {
+	return hash.ZeroHash256, nil
+}
+
+func (dao *testBlockDAO) GetBlockByHeight(height uint64) (*block.Block, error) {
+	return dao.blocks[height], nil
+}
+
+func (dao *testBlockDAO) GetReceipts(height uint64) ([]*action.Receipt, error) {
+	return dao.receipts[height], nil
+}
+
+func TestFeeHistory(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// block 1 is empty, block 2 and 3 consume 40% and 10% of block gas limit
+	prices := [][]int64{{}, {20, 10}, {5}}
+	gasConsumed := [][]uint64{{}, {30000, 10000}, {10000}}
+	dao := &testBlockDAO{
+		blocks:   make(map[uint64]*block.Block),
+		receipts: make(map[uint64][]*action.Receipt),
+	}
+	nonce := uint64(1)
+	for i := range prices {
+		height := uint64(i) + 1
+		var (
+			acts     []action.SealedEnvelope
+			receipts []*action.Receipt
+		)
+		for j, price := range prices[i] {
+			tsf, err := testutil.SignedTransfer(identityset.Address(27).String(), identityset.PrivateKey(0), nonce, big.NewInt(1), nil, 100000, big.NewInt(price))
+			require.NoError(err)
+			nonce++
+			acts = append(acts, tsf)
+			receipts = append(receipts, &action.Receipt{ActionHash: tsf.Hash(), GasConsumed: gasConsumed[i][j]})
+		}
+		blk, err := block.NewTestingBuilder().SetHeight(height).AddActions(acts...).SignAndBuild(identityset.PrivateKey(0))
+		require.NoError(err)
+		dao.blocks[height] = &blk
+		dao.receipts[height] = receipts
+	}
+	bc := mock_blockchain.NewMockBlockchain(ctrl)
+	bc.EXPECT().TipHeight().Return(uint64(3)).AnyTimes()
+	g := genesis.Default
+	g.BlockGasLimit = 100000
+	bc.EXPECT().Genesis().Return(g).AnyTimes()
+	gs := NewGasStation(bc, nil, dao, config.Default.API)
+
+	history, err := gs.FeeHistory(2, 0, []float64{0, 25, 50, 100})
+	require.NoError(err)
+	require.Equal(uint64(2), history.OldestBlock)
+	require.Equal([]float64{0.4, 0.1}, history.GasUsedRatio)
+	require.Equal([][]*big.Int{
+		{big.NewInt(10), big.NewInt(10), big.NewInt(20), big.NewInt(20)},
+		{big.NewInt(5), big.NewInt(5), big.NewInt(5), big.NewInt(5)},
+	}, history.Reward)
+
+	// block count is capped by the number of blocks
+	history, err = gs.FeeHistory(10, 1, []float64{50})
+	require.NoError(err)
+	require.Equal(uint64(1), history.OldestBlock)
+	require.Equal([]float64{0}, history.GasUsedRatio)
+	require.Equal([][]*big.Int{{big.NewInt(0)}}, history.Reward)
+
+	for _, test := range []struct {
+		blockCount, newestBlock uint64
+		percentiles             []float64
+	}{
+		{0, 0, nil},
+		{1, 4, nil},
+		{1, 0, []float64{50, 10}},
+		{1, 0, []float64{101}},
+	} {
+		_, err = gs.FeeHistory(test.blockCount, test.newestBlock, test.percentiles)
+		require.Equal(ErrInvalidFeeHistoryQuery, errors.Cause(err))
+	}
+}
+
+func TestGasStationReceiveBlock(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dao := &testBlockDAO{
+		blocks: make(map[uint64]*block.Block),
+	}
+	nonce := uint64(1)
+	newBlock := func(height uint64, prices ...int64) *block.Block {
+		var acts []action.SealedEnvelope
+		for _, price := range prices {
+			tsf, err := testutil.SignedTransfer(identityset.Address(27).String(), identityset.PrivateKey(0), nonce, big.NewInt(1), nil, 100000, big.NewInt(price*unit.Qev))
+			require.NoError(err)
+			nonce++
+			acts = append(acts, tsf)
+		}
+		blk, err := block.NewTestingBuilder().SetHeight(height).AddActions(acts...).SignAndBuild(identityset.PrivateKey(0))
+		require.NoError(err)
+		dao.blocks[height] = &blk
+		return &blk
+	}
+	cfg := config.Default.API
+	cfg.GasStation.SuggestBlockWindow = 3
+	cfg.GasStation.Percentile = 50
+	// blocks 1 and 2 are in dao before gas station starts
+	newBlock(1, 5, 3)
+	newBlock(2, 4)
+	tip := uint64(2)
+	bc := mock_blockchain.NewMockBlockchain(ctrl)
+	bc.EXPECT().TipHeight().DoAndReturn(func() uint64 { return tip }).AnyTimes()
+	gs := NewGasStation(bc, nil, dao, cfg)
+
+	gp, err := gs.SuggestGasPrice()
+	require.NoError(err)
+	// smallest prices in window are 3 and 4
+	require.Equal(uint64(3*unit.Qev), gp)
+
+	for _, test := range []struct {
+		prices   []int64
+		expected uint64
+	}{
+		// window is [3, 4, 6]
+		{[]int64{6}, 4},
+		// window is [4, 6, nil]
+		{nil, 4},
+		// window is [6, nil, 7]
+		{[]int64{8, 7}, 6},
+	} {
+		tip++
+		require.NoError(gs.ReceiveBlock(newBlock(tip, test.prices...)))
+		gp, err = gs.SuggestGasPrice()
+		require.NoError(err)
+		require.Equal(test.expected*uint64(unit.Qev), gp)
+	}
+	require.Len(gs.samples, 3)
+
+	// a block already in window is ignored
+	require.NoError(gs.ReceiveBlock(dao.blocks[tip]))
+	require.Len(gs.samples, 3)
+
+	// missing blocks are read from dao, window is [9, 10, 2]
+	newBlock(tip+1, 9)
+	newBlock(tip+2, 10)
+	tip += 3
+	require.NoError(gs.ReceiveBlock(newBlock(tip, 2)))
+	gp, err = gs.SuggestGasPrice()
+	require.NoError(err)
+	require.Equal(uint64(9*unit.Qev), gp)
+	require.Equal(tip, gs.height)
+
+	// the reverted blocks are dropped from window, window is [9]
+	require.NoError(gs.RevertBlock(dao.blocks[tip]))
+	require.NoError(gs.RevertBlock(dao.blocks[tip-1]))
+	tip -= 2
+	require.Len(gs.samples, 1)
+	require.Equal(tip, gs.height)
+	gp, err = gs.SuggestGasPrice()
+	require.NoError(err)
+	require.Equal(uint64(9*unit.Qev), gp)
+	// a block not in window is ignored
+	require.NoError(gs.RevertBlock(newBlock(tip+5, 1)))
+	require.Len(gs.samples, 1)
+
+	// new blocks are added after the reverted ones, window is [9, 1, 3]
+	require.NoError(gs.ReceiveBlock(newBlock(tip+1, 1)))
+	tip += 2
+	require.NoError(gs.ReceiveBlock(newBlock(tip, 3)))
+	gp, err = gs.SuggestGasPrice()
+	require.NoError(err)
+	require.Equal(uint64(3*unit.Qev), gp)
+	require.Equal(tip, gs.height)
+
+	// gas station handles the reverted blocks as a subscriber of chain
+	var _ blockchain.BlockRevertSubscriber = gs
+}
+
+func TestGasStationPoolPressure(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bc := mock_blockchain.NewMockBlockchain(ctrl)
+	bc.EXPECT().TipHeight().Return(uint64(0)).AnyTimes()
+	ap := mock_actpool.NewMockActPool(ctrl)
+	ap.EXPECT().GetCapacity().Return(uint64(1000)).AnyTimes()
+	cfg := config.Default.API
+	cfg.GasStation.DefaultGas = 100
+	cfg.GasStation.ActPoolPressureThreshold = 50
+	cfg.GasStation.ActPoolPressureBumpPct = 100
+	gs := NewGasStation(bc, nil, &testBlockDAO{}, cfg, WithActPool(ap))
+
+	for _, test := range []struct {
+		size     uint64
+		expected uint64
+	}{
+		{0, 100},
+		{500, 100},
+		{750, 150},
+		{1000, 200},
+		{2000, 200},
+	} {
+		ap.EXPECT().GetSize().Return(test.size).Times(1)
+		gp, err := gs.SuggestGasPrice()
+		require.NoError(err)
+		require.Equal(test.expected, gp)
+	}
+
+	// the gas price is not raised by default
+	cfg.GasStation.ActPoolPressureBumpPct = config.Default.API.GasStation.ActPoolPressureBumpPct
+	gs = NewGasStation(bc, nil, &testBlockDAO{}, cfg, WithActPool(ap))
+	ap.EXPECT().GetSize().Return(uint64(1000)).Times(1)
+	gp, err := gs.SuggestGasPrice()
+	require.NoError(err)
+	require.Equal(uint64(100), gp)
+}
+
 func TestEstimateGasForAction(t *testing.T) {
 	require := require.New(t)
 	act := getAction()
@@ -248,7 +248,7 @@ func printReceiptProto(receipt *iotextypes.Receipt) string {
 			result += fmt.Sprintf("\nbucket index: %d", index)
 		}
 	}
-	if receipt.Status == uint64(iotextypes.ReceiptStatus_ErrExecutionReverted) {
+	if receipt.Status == uint64(iotextypes.ReceiptStatus_ErrExecutionReverted) || receipt.ExecutionRevertMsg != "" {
 		result += fmt.Sprintf("\nexecution revert reason: %s", receipt.ExecutionRevertMsg)
 	}
 	return result
@@ -55,6 +55,7 @@ func init() {
 	BCCmd.AddCommand(bcInfoCmd)
 	BCCmd.AddCommand(bcBucketListCmd)
 	BCCmd.AddCommand(bcBucketCmd)
+	BCCmd.AddCommand(bcFeeHistoryCmd)
 	BCCmd.PersistentFlags().StringVar(&config.ReadConfig.Endpoint, "endpoint",
 		config.ReadConfig.Endpoint, config.TranslateInLang(flagEndpointUsages, config.UILanguage))
 	BCCmd.PersistentFlags().BoolVar(&config.Insecure, "insecure", config.Insecure,
@@ -0,0 +1,137 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package bc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-core/api/apipb"
+	"github.com/iotexproject/iotex-core/ioctl/config"
+	"github.com/iotexproject/iotex-core/ioctl/output"
+	"github.com/iotexproject/iotex-core/ioctl/util"
+	"github.com/iotexproject/iotex-core/ioctl/validator"
+)
+
+var (
+	newestBlock       uint64
+	rewardPercentiles []float64
+)
+
+// Multi-language support
+var (
+	bcFeeHistoryCmdShorts = map[config.Language]string{
+		config.English: "Get gas used ratio and gas price percentiles of recent blocks",
+		config.Chinese: "获取最近区块的gas使用率和gas价格分位数",
+	}
+	bcFeeHistoryCmdUses = map[config.Language]string{
+		config.English: "feehistory BLOCK_COUNT [-n NEWEST_BLOCK] [-p PERCENTILES]",
+		config.Chinese: "feehistory 区块数量 [-n 最新区块高度] [-p 分位数]",
+	}
+	flagNewestBlockUsages = map[config.Language]string{
+		config.English: "height of the newest block in range (default using tip height)",
+		config.Chinese: "范围内最新区块的高度（默认为当前区块高度）",
+	}
+	flagPercentilesUsages = map[config.Language]string{
+		config.English: "gas price percentiles in ascending order, e.g., slow, normal and fast options",
+		config.Chinese: "升序排列的gas价格分位数，例如慢速、普通和快速选项",
+	}
+)
+
+// bcFeeHistoryCmd represents the bc feehistory command
+var bcFeeHistoryCmd = &cobra.Command{
+	Use:   config.TranslateInLang(bcFeeHistoryCmdUses, config.UILanguage),
+	Short: config.TranslateInLang(bcFeeHistoryCmdShorts, config.UILanguage),
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		err := getFeeHistory(args)
+		return output.PrintError(err)
+	},
+}
+
+func init() {
+	bcFeeHistoryCmd.Flags().Uint64VarP(&newestBlock, "newest-block", "n", 0,
+		config.TranslateInLang(flagNewestBlockUsages, config.UILanguage))
+	bcFeeHistoryCmd.Flags().Float64SliceVarP(&rewardPercentiles, "percentiles", "p", []float64{25, 50, 75},
+		config.TranslateInLang(flagPercentilesUsages, config.UILanguage))
+}
+
+type blockFee struct {
+	Height       uint64   `json:"height"`
+	GasUsedRatio float64  `json:"gasUsedRatio"`
+	GasPrices    []string `json:"gasPrices"`
+}
+
+type feeHistoryMessage struct {
+	Node        string      `json:"node"`
+	Percentiles []float64   `json:"percentiles"`
+	Blocks      []*blockFee `json:"blocks"`
+}
+
+func (m *feeHistoryMessage) String() string {
+	if output.Format == "" {
+		message := fmt.Sprintf("Blockchain Node: %s\nPercentiles: %v\n%s", m.Node, m.Percentiles, output.JSONString(m.Blocks))
+		return message
+	}
+	return output.FormatString(output.Result, m)
+}
+
+// getFeeHistory gets gas fee history of recent blocks
+func getFeeHistory(args []string) error {
+	blockCount, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return output.NewError(output.ConvertError, "failed to convert block count", err)
+	}
+	if err := validator.ValidatePositiveNumber(int64(blockCount)); err != nil {
+		return output.NewError(output.ValidationError, "invalid block count", err)
+	}
+	conn, err := util.ConnectToEndpoint(config.ReadConfig.SecureConnect && !config.Insecure)
+	if err != nil {
+		return output.NewError(output.NetworkError, "failed to connect to endpoint", err)
+	}
+	defer conn.Close()
+	cli := apipb.NewAPIServiceClient(conn)
+	request := &apipb.FeeHistoryRequest{
+		BlockCount:        blockCount,
+		NewestBlock:       newestBlock,
+		RewardPercentiles: rewardPercentiles,
+	}
+	ctx := context.Background()
+
+	jwtMD, err := util.JwtAuth()
+	if err == nil {
+		ctx = metautils.NiceMD(jwtMD).ToOutgoing(ctx)
+	}
+
+	response, err := cli.FeeHistory(ctx, request)
+	if err != nil {
+		sta, ok := status.FromError(err)
+		if ok {
+			return output.NewError(output.APIError, sta.Message(), nil)
+		}
+		return output.NewError(output.NetworkError, "failed to invoke FeeHistory api", err)
+	}
+	message := feeHistoryMessage{
+		Node:        config.ReadConfig.Endpoint,
+		Percentiles: rewardPercentiles,
+	}
+	for i, ratio := range response.GasUsedRatio {
+		message.Blocks = append(message.Blocks, &blockFee{
+			Height:       response.OldestBlock + uint64(i),
+			GasUsedRatio: ratio,
+			GasPrices:    response.Reward[i].GetGasPrices(),
+		})
+	}
+	fmt.Println(message.String())
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+// Package ratelimit provides token buckets to limit the rate of calls per key.
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+type (
+	bucket struct {
+		tokens float64
+		last   time.Time
+	}
+
+	// Limiter is a set of token buckets identified by key, e.g., sender address, peer id, client ip or api key. Each
+	// bucket is refilled avg tokens per second up to burst tokens, and a call consumes the tokens of its cost
+	Limiter struct {
+		mutex         sync.Mutex
+		rate          float64
+		burst         float64
+		buckets       map[string]*bucket
+		now           func() time.Time
+		pruneInterval time.Duration
+		lastPrune     time.Time
+	}
+
+	// Option is the option to create a limiter
+	Option func(*Limiter)
+)
+
+// WithClock sets the function returning the current time, which is time.Now by default
+func WithClock(now func() time.Time) Option {
+	return func(l *Limiter) {
+		l.now = now
+	}
+}
+
+// WithPruneInterval makes the limiter remove the refilled buckets of idle keys once per interval on calls, otherwise
+// the buckets are removed by calling Prune
+func WithPruneInterval(interval time.Duration) Option {
+	return func(l *Limiter) {
+		l.pruneInterval = interval
+	}
+}
+
+// New creates a limiter, which refills avg tokens per second up to burst tokens for each key
+func New(avg, burst int, opts ...Option) *Limiter {
+	if burst < avg {
+		burst = avg
+	}
+	l := &Limiter{
+		rate:    float64(avg),
+		burst:   float64(burst),
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// Allow consumes a token of the key, and returns false if there is no token left
+func (l *Limiter) Allow(key string) bool {
+	return l.AllowN(key, 1)
+}
+
+// AllowN consumes cost tokens of the key, and returns false if there are not enough tokens left. The cost is capped by
+// the burst, so that an expensive call drains the bucket rather than being rejected forever
+func (l *Limiter) AllowN(key string, cost uint64) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.now()
+	if l.pruneInterval > 0 && now.Sub(l.lastPrune) >= l.pruneInterval {
+		l.prune(now)
+	}
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+	b.tokens += now.Sub(b.last).Seconds() * l.rate
+	if b.tokens > l.burst {
+		b.tokens = l.burst
+	}
+	b.last = now
+	c := float64(cost)
+	if c > l.burst {
+		c = l.burst
+	}
+	if b.tokens < c {
+		return false
+	}
+	b.tokens -= c
+	return true
+}
+
+// Prune removes the buckets which have been refilled
+func (l *Limiter) Prune() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.prune(l.now())
+}
+
+// Len returns the number of buckets in use
+func (l *Limiter) Len() int {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return len(l.buckets)
+}
+
+func (l *Limiter) prune(now time.Time) {
+	for key, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*l.rate >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastPrune = now
+}
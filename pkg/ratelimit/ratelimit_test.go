@@ -0,0 +1,68 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiter(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Unix(1000, 0)
+	l := New(2, 3, WithClock(func() time.Time { return now }))
+	for i := 0; i < 3; i++ {
+		require.True(l.Allow("a"))
+	}
+	require.False(l.Allow("a"))
+	// other keys have their own buckets
+	require.True(l.Allow("b"))
+
+	// refill 1 token after half a second
+	now = now.Add(500 * time.Millisecond)
+	require.True(l.Allow("a"))
+	require.False(l.Allow("a"))
+
+	// bucket b is refilled and pruned, bucket a is still in use
+	now = now.Add(time.Second)
+	l.Prune()
+	require.Equal(1, l.Len())
+	_, ok := l.buckets["a"]
+	require.True(ok)
+
+	// burst is at least avg
+	require.Equal(float64(2), New(2, 1).burst)
+}
+
+func TestLimiterAllowN(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Unix(1000, 0)
+	l := New(10, 50, WithClock(func() time.Time { return now }), WithPruneInterval(time.Minute))
+	require.True(l.AllowN("a", 30))
+	require.True(l.AllowN("a", 20))
+	require.False(l.AllowN("a", 1))
+	require.True(l.AllowN("b", 50))
+
+	// refilled 10 tokens per second
+	now = now.Add(2 * time.Second)
+	require.False(l.AllowN("a", 21))
+	require.True(l.AllowN("a", 20))
+
+	// the cost beyond burst drains the bucket
+	now = now.Add(10 * time.Second)
+	require.True(l.AllowN("a", 1000))
+	require.False(l.AllowN("a", 1))
+
+	// the refilled buckets are pruned once per prune interval
+	now = now.Add(time.Minute)
+	require.True(l.AllowN("c", 1))
+	require.Equal(1, l.Len())
+}
@@ -85,6 +85,8 @@ type (
 		DeleteTipBlock(*block.Block) error
 		StateAtHeight(uint64, interface{}, ...protocol.StateOption) error
 		StatesAtHeight(uint64, ...protocol.StateOption) (state.Iterator, error)
+		WorkingSetAtHeight(context.Context, uint64, ...action.SealedEnvelope) (protocol.StateManager, error)
+		StateProofAtHeight(uint64, ...protocol.StateOption) (*StateProof, error)
 	}
 
 	// factory implements StateFactory interface, tracks changes to account/contract and batch-commits to DB
@@ -274,11 +276,15 @@ func (sf *factory) Height() (uint64, error) {
 }
 
 func (sf *factory) newWorkingSet(ctx context.Context, height uint64) (*workingSet, error) {
+	return sf.newWorkingSetWithTrieRootKey(ctx, height, ArchiveTrieRootKey, true)
+}
+
+func (sf *factory) newWorkingSetWithTrieRootKey(ctx context.Context, height uint64, rootKey string, create bool) (*workingSet, error) {
 	flusher, err := db.NewKVStoreFlusher(sf.dao, batch.NewCachedBatch(), sf.flusherOptions(ctx, height)...)
 	if err != nil {
 		return nil, err
 	}
-	tlt, err := newTwoLayerTrie(ArchiveTrieNamespace, flusher.KVStoreWithBuffer(), ArchiveTrieRootKey, true)
+	tlt, err := newTwoLayerTrie(ArchiveTrieNamespace, flusher.KVStoreWithBuffer(), rootKey, create)
 	if err != nil {
 		return nil, err
 	}
@@ -289,9 +295,10 @@ func (sf *factory) newWorkingSet(ctx context.Context, height uint64) (*workingSe
 	trieRoots := make(map[int][]byte)
 
 	return &workingSet{
-		height:    height,
-		finalized: false,
-		dock:      protocol.NewDock(),
+		height:        height,
+		finalized:     false,
+		dock:          protocol.NewDock(),
+		dockSnapshots: make(map[int]protocol.CloneableDock),
 		getStateFunc: func(ns string, key []byte, s interface{}) error {
 			return readState(tlt, ns, key, s)
 		},
@@ -483,7 +490,11 @@ func (sf *factory) NewBlockBuilder(
 			}
 		}
 	}
-	blkBuilder, err := ws.CreateBuilder(ctx, ap, postSystemActions, sf.cfg.Chain.AllowedBlockGasResidue)
+	strategy, err := NewPackingStrategy(sf.cfg.Chain.PackingStrategy)
+	if err != nil {
+		return nil, err
+	}
+	blkBuilder, err := ws.CreateBuilder(ctx, ap, postSystemActions, sf.cfg.Chain.AllowedBlockGasResidue, strategy)
 	if err != nil {
 		return nil, err
 	}
@@ -572,6 +583,35 @@ func (sf *factory) DeleteTipBlock(_ *block.Block) error {
 	return errors.Wrap(ErrNotSupported, "cannot delete tip block from factory")
 }
 
+// WorkingSetAtHeight returns a working set atop the confirmed state at height-1, in which the given actions preceding
+// the action to replay in the block at height have been run -- archive mode
+func (sf *factory) WorkingSetAtHeight(ctx context.Context, height uint64, preacts ...action.SealedEnvelope) (protocol.StateManager, error) {
+	if !sf.saveHistory {
+		return nil, ErrNoArchiveData
+	}
+	if height == 0 {
+		return nil, errors.New("cannot replay actions in genesis block")
+	}
+	sf.mutex.Lock()
+	if height > sf.currentChainHeight+1 {
+		sf.mutex.Unlock()
+		return nil, errors.Errorf("query height %d is higher than tip height %d", height-1, sf.currentChainHeight)
+	}
+	ws, err := sf.newWorkingSetWithTrieRootKey(ctx, height, fmt.Sprintf("%s-%d", ArchiveTrieRootKey, height-1), false)
+	sf.mutex.Unlock()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to obtain working set at height %d", height-1)
+	}
+	ws.statesFunc = func(opts ...protocol.StateOption) (uint64, state.Iterator, error) {
+		iter, err := sf.StatesAtHeight(height-1, opts...)
+		return height - 1, iter, err
+	}
+	if _, err := ws.runActions(protocol.WithRegistry(ctx, sf.registry), preacts); err != nil {
+		return nil, err
+	}
+	return ws, nil
+}
+
 // StateAtHeight returns a confirmed state at height -- archive mode
 func (sf *factory) StateAtHeight(height uint64, s interface{}, opts ...protocol.StateOption) error {
 	sf.mutex.RLock()
@@ -0,0 +1,152 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"math/big"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/actpool"
+	"github.com/iotexproject/iotex-core/actpool/actioniterator"
+	"github.com/iotexproject/iotex-core/config"
+)
+
+var (
+	packingMtc = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "iotex_block_packing_skipped_actions",
+			Help: "Number of pending actions skipped when packing new block.",
+		},
+		[]string{"strategy", "reason"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(packingMtc)
+}
+
+type (
+	// Simulator runs an action on the state of new block without committing the state changes, and returns the receipt
+	Simulator func(action.SealedEnvelope) (*action.Receipt, error)
+
+	// PackingStrategy decides the order and the selection of pending actions to be packed into new block
+	PackingStrategy interface {
+		// Name returns the name of the strategy
+		Name() string
+		// ActionIterator returns an iterator over the pending actions in pool
+		ActionIterator(actpool.ActPool, Simulator) actioniterator.ActionIterator
+		// SkipFailedActions returns true if the actions failed in execution should be excluded from new block
+		SkipFailedActions() bool
+	}
+
+	priceStrategy struct{}
+
+	simulationStrategy struct{}
+
+	fifoStrategy struct{}
+
+	// simulatedFee is the fee an action pays in simulation, and its gas limit
+	simulatedFee struct {
+		fee      *big.Int
+		gasLimit uint64
+	}
+)
+
+// NewPackingStrategy creates the packing strategy by name
+func NewPackingStrategy(name string) (PackingStrategy, error) {
+	switch name {
+	case config.PricePackingStrategy:
+		return &priceStrategy{}, nil
+	case config.SimulationPackingStrategy:
+		return &simulationStrategy{}, nil
+	case config.FIFOPackingStrategy:
+		return &fifoStrategy{}, nil
+	default:
+		return nil, errors.Errorf("unknown packing strategy %s", name)
+	}
+}
+
+func (*priceStrategy) Name() string { return config.PricePackingStrategy }
+
+func (*priceStrategy) ActionIterator(ap actpool.ActPool, _ Simulator) actioniterator.ActionIterator {
+	return actioniterator.NewActionIterator(ap.PendingActionMap())
+}
+
+func (*priceStrategy) SkipFailedActions() bool { return false }
+
+func (*simulationStrategy) Name() string { return config.SimulationPackingStrategy }
+
+// ActionIterator picks the head action with the highest fee per gas after simulation among accounts. An action needs
+// its gas limit left in block to be picked, so the fee it pays in simulation is divided by its gas limit rather than
+// the gas consumed. The actions failing in simulation go last. Each action is simulated once when it becomes the head
+// of its account, on the state at that time
+func (*simulationStrategy) ActionIterator(ap actpool.ActPool, simulate Simulator) actioniterator.ActionIterator {
+	fees := make(map[hash.Hash256]*simulatedFee)
+	feeOf := func(selp action.SealedEnvelope) *simulatedFee {
+		h := selp.Hash()
+		if fee, ok := fees[h]; ok {
+			return fee
+		}
+		var fee *simulatedFee
+		receipt, err := simulate(selp)
+		if err == nil && receipt != nil && receipt.Status == uint64(iotextypes.ReceiptStatus_Success) {
+			fee = &simulatedFee{
+				fee:      new(big.Int).Mul(selp.GasPrice(), new(big.Int).SetUint64(receipt.GasConsumed)),
+				gasLimit: selp.GasLimit(),
+			}
+		}
+		fees[h] = fee
+		return fee
+	}
+	return actioniterator.NewActionIteratorWithOrder(ap.PendingActionMap(), func(a, b action.SealedEnvelope) bool {
+		feeA, feeB := feeOf(a), feeOf(b)
+		if feeA == nil && feeB == nil {
+			return a.GasPrice().Cmp(b.GasPrice()) > 0
+		}
+		if feeA == nil || feeB == nil {
+			return feeA != nil
+		}
+		// feeA/gasLimitA > feeB/gasLimitB
+		x := new(big.Int).Mul(feeA.fee, new(big.Int).SetUint64(feeB.gasLimit))
+		y := new(big.Int).Mul(feeB.fee, new(big.Int).SetUint64(feeA.gasLimit))
+		return x.Cmp(y) > 0
+	})
+}
+
+func (*simulationStrategy) SkipFailedActions() bool { return true }
+
+func (*fifoStrategy) Name() string { return config.FIFOPackingStrategy }
+
+func (*fifoStrategy) ActionIterator(ap actpool.ActPool, _ Simulator) actioniterator.ActionIterator {
+	pending := ap.PendingActionMap()
+	// read the arrival orders from pool once, rather than in every comparison
+	arrivals := make(map[hash.Hash256]uint64)
+	for _, acts := range pending {
+		for _, act := range acts {
+			h := act.Hash()
+			if arrival, ok := ap.ArrivalOrder(h); ok {
+				arrivals[h] = arrival
+			}
+		}
+	}
+	return actioniterator.NewActionIteratorWithOrder(pending, func(a, b action.SealedEnvelope) bool {
+		arrivalA, okA := arrivals[a.Hash()]
+		arrivalB, okB := arrivals[b.Hash()]
+		if okA != okB {
+			// actions of unknown arrival go last
+			return okA
+		}
+		return arrivalA < arrivalB
+	})
+}
+
+func (*fifoStrategy) SkipFailedActions() bool { return false }
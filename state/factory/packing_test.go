@@ -0,0 +1,180 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/account"
+	"github.com/iotexproject/iotex-core/action/protocol/rewarding"
+	"github.com/iotexproject/iotex-core/action/protocol/staking"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/unit"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_actpool"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestPackingStrategy(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_, err := NewPackingStrategy("random")
+	require.Error(err)
+	for _, name := range []string{
+		config.PricePackingStrategy,
+		config.SimulationPackingStrategy,
+		config.FIFOPackingStrategy,
+	} {
+		strategy, err := NewPackingStrategy(name)
+		require.NoError(err)
+		require.Equal(name, strategy.Name())
+		require.Equal(name == config.SimulationPackingStrategy, strategy.SkipFailedActions())
+	}
+
+	tsf1, err := testutil.SignedTransfer(identityset.Address(1).String(), identityset.PrivateKey(27), 1, big.NewInt(1), nil, 10000, big.NewInt(1))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(identityset.Address(1).String(), identityset.PrivateKey(28), 1, big.NewInt(1), nil, 10000, big.NewInt(10))
+	require.NoError(err)
+	tsf3, err := testutil.SignedTransfer(identityset.Address(1).String(), identityset.PrivateKey(29), 1, big.NewInt(1), nil, 10000, big.NewInt(5))
+	require.NoError(err)
+	arrivals := map[hash.Hash256]uint64{
+		tsf1.Hash(): 0,
+		tsf2.Hash(): 1,
+	}
+	ap := mock_actpool.NewMockActPool(ctrl)
+	ap.EXPECT().PendingActionMap().DoAndReturn(func() map[string][]action.SealedEnvelope {
+		return map[string][]action.SealedEnvelope{
+			identityset.Address(27).String(): {tsf1},
+			identityset.Address(28).String(): {tsf2},
+			identityset.Address(29).String(): {tsf3},
+		}
+	}).Times(3)
+	ap.EXPECT().ArrivalOrder(gomock.Any()).DoAndReturn(func(h hash.Hash256) (uint64, bool) {
+		arrival, ok := arrivals[h]
+		return arrival, ok
+	}).AnyTimes()
+
+	// tsf1 pays 1 per gas limit, tsf3 pays 0.5 per gas limit, and tsf2 fails in simulation
+	simulate := func(selp action.SealedEnvelope) (*action.Receipt, error) {
+		switch selp.Hash() {
+		case tsf1.Hash():
+			return &action.Receipt{Status: uint64(iotextypes.ReceiptStatus_Success), GasConsumed: 10000}, nil
+		case tsf3.Hash():
+			return &action.Receipt{Status: uint64(iotextypes.ReceiptStatus_Success), GasConsumed: 1000}, nil
+		default:
+			return &action.Receipt{Status: uint64(iotextypes.ReceiptStatus_Failure), GasConsumed: 10000}, nil
+		}
+	}
+
+	for _, c := range []struct {
+		name     string
+		expected []action.SealedEnvelope
+	}{
+		{config.PricePackingStrategy, []action.SealedEnvelope{tsf2, tsf3, tsf1}},
+		{config.SimulationPackingStrategy, []action.SealedEnvelope{tsf1, tsf3, tsf2}},
+		{config.FIFOPackingStrategy, []action.SealedEnvelope{tsf1, tsf2, tsf3}},
+	} {
+		strategy, err := NewPackingStrategy(c.name)
+		require.NoError(err)
+		ai := strategy.ActionIterator(ap, simulate)
+		acts := make([]action.SealedEnvelope, 0)
+		for {
+			act, ok := ai.Next()
+			if !ok {
+				break
+			}
+			acts = append(acts, act)
+		}
+		require.Equal(c.expected, acts)
+	}
+}
+
+func TestSimulationPackingStates(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// the candidate register is simulated before it is run in the block, and the simulation should not leak into the
+	// block. It pays a higher price, so both strategies pack it first
+	register, err := testutil.SignedCandidateRegister(1, "test", identityset.Address(1).String(), identityset.Address(1).String(),
+		identityset.Address(28).String(), config.Default.Genesis.RegistrationConsts.MinSelfStake, 1, false, nil, 100000, big.NewInt(1), identityset.PrivateKey(28))
+	require.NoError(err)
+	tsf, err := testutil.SignedTransfer(identityset.Address(1).String(), identityset.PrivateKey(29), 1, big.NewInt(1), nil, 10000, big.NewInt(0))
+	require.NoError(err)
+	ap := mock_actpool.NewMockActPool(ctrl)
+	ap.EXPECT().PendingActionMap().DoAndReturn(func() map[string][]action.SealedEnvelope {
+		return map[string][]action.SealedEnvelope{
+			identityset.Address(28).String(): {register},
+			identityset.Address(29).String(): {tsf},
+		}
+	}).AnyTimes()
+
+	mint := func(strategy string, statedb bool) (hash.Hash256, staking.CandidateList) {
+		testPath, err := testutil.PathOfTempFile(stateDBPath)
+		require.NoError(err)
+		defer testutil.CleanupPath(t, testPath)
+		cfg := config.Default
+		cfg.Chain.PackingStrategy = strategy
+		cfg.Chain.TrieDBPath = testPath
+		cfg.DB.DbPath = testPath
+		cfg.Genesis.InitBalanceMap[identityset.Address(28).String()] = unit.ConvertIotxToRau(2000000).String()
+		cfg.Genesis.InitBalanceMap[identityset.Address(29).String()] = "200"
+		registry := protocol.NewRegistry()
+		var sf Factory
+		if statedb {
+			sf, err = NewStateDB(cfg, DefaultStateDBOption(), RegistryStateDBOption(registry))
+		} else {
+			sf, err = NewFactory(cfg, PrecreatedTrieDBOption(db.NewBoltDB(cfg.DB)), RegistryOption(registry))
+		}
+		require.NoError(err)
+		require.NoError(account.NewProtocol(rewarding.DepositGas).Register(registry))
+		sp, err := staking.NewProtocol(rewarding.DepositGas, cfg.Genesis.Staking, nil, cfg.Genesis.GreenlandBlockHeight)
+		require.NoError(err)
+		require.NoError(sp.Register(registry))
+		ctx := protocol.WithBlockchainCtx(context.Background(), protocol.BlockchainCtx{Genesis: cfg.Genesis})
+		require.NoError(sf.Start(protocol.WithBlockCtx(ctx, protocol.BlockCtx{})))
+		defer func() {
+			require.NoError(sf.Stop(ctx))
+		}()
+
+		ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+			BlockHeight: 1,
+			Producer:    identityset.Address(27),
+			GasLimit:    cfg.Genesis.BlockGasLimit,
+		})
+		blkBuilder, err := sf.NewBlockBuilder(ctx, ap, nil)
+		require.NoError(err)
+		blk, err := blkBuilder.SignAndBuild(identityset.PrivateKey(27))
+		require.NoError(err)
+		require.Equal([]action.SealedEnvelope{register, tsf}, blk.Actions)
+		require.NoError(sf.PutBlock(ctx, &blk))
+		csr, err := staking.ConstructBaseView(sf)
+		require.NoError(err)
+		return blk.DeltaStateDigest(), csr.AllCandidates()
+	}
+
+	// the simulation does not leak the candidate into the states or the view of staking protocol
+	for _, statedb := range []bool{false, true} {
+		digest, cands := mint(config.PricePackingStrategy, statedb)
+		require.Len(cands, 1)
+		simDigest, simCands := mint(config.SimulationPackingStrategy, statedb)
+		require.Equal(digest, simDigest)
+		require.Equal(cands, simCands)
+	}
+}
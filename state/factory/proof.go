@@ -0,0 +1,92 @@
+// Copyright (c) 2021 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/db/trie"
+	"github.com/iotexproject/iotex-core/db/trie/mptrie"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+// StateProof is the merkle proof of a state in the two layer state trie
+type StateProof struct {
+	// Height is the height of the state
+	Height uint64
+	// RootHash is the root hash of the state trie at the height
+	RootHash []byte
+	// NamespaceProof is the proof of the namespace in layer one, whose value is the root hash of the namespace
+	NamespaceProof [][]byte
+	// KeyProof is the proof of the key in the layer two trie of the namespace
+	KeyProof [][]byte
+}
+
+// StateProofAtHeight returns the merkle proof of a state at height, the state at a height lower than the tip height
+// is only available in archive mode
+func (sf *factory) StateProofAtHeight(height uint64, opts ...protocol.StateOption) (*StateProof, error) {
+	cfg, err := processOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+	// the proof of the read only trie flushes the trie, so the write lock is needed
+	sf.mutex.Lock()
+	defer sf.mutex.Unlock()
+	if height > sf.currentChainHeight {
+		return nil, errors.Errorf("query height %d is higher than tip height %d", height, sf.currentChainHeight)
+	}
+	tlt := sf.twoLayerTrie
+	if height != sf.currentChainHeight {
+		if !sf.saveHistory {
+			return nil, ErrNoArchiveData
+		}
+		if tlt, err = newTwoLayerTrie(ArchiveTrieNamespace, sf.dao, fmt.Sprintf("%s-%d", ArchiveTrieRootKey, height), false); err != nil {
+			return nil, errors.Wrapf(err, "failed to generate trie for %d", height)
+		}
+		if err := tlt.Start(context.Background()); err != nil {
+			return nil, err
+		}
+		defer tlt.Stop(context.Background())
+	}
+	rootHash, err := tlt.RootHash()
+	if err != nil {
+		return nil, err
+	}
+	nsProof, keyProof, err := tlt.GetProof(namespaceKey(cfg.Namespace), toLegacyKey(cfg.Key))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get proof of ns = %s and key = %x", cfg.Namespace, cfg.Key)
+	}
+
+	return &StateProof{
+		Height:         height,
+		RootHash:       rootHash,
+		NamespaceProof: nsProof,
+		KeyProof:       keyProof,
+	}, nil
+}
+
+// StateProofAtHeight is not supported by state db, which does not maintain the state trie
+func (sdb *stateDB) StateProofAtHeight(uint64, ...protocol.StateOption) (*StateProof, error) {
+	return nil, errors.Wrap(ErrNotSupported, "state db does not support state proof")
+}
+
+// VerifyStateProof verifies the proof of a state against the root hash of the state trie, and returns the serialized
+// state. state.ErrStateNotExist is returned if the proof shows the state does not exist
+func VerifyStateProof(rootHash []byte, ns string, key []byte, proof *StateProof) ([]byte, error) {
+	if proof == nil {
+		return nil, errors.Wrap(mptrie.ErrInvalidProof, "empty proof")
+	}
+	value, err := mptrie.VerifyTwoLayerProof(rootHash, namespaceKey(ns), toLegacyKey(key), proof.NamespaceProof, proof.KeyProof)
+	if errors.Cause(err) == trie.ErrNotExist {
+		return nil, errors.Wrapf(state.ErrStateNotExist, "state of ns = %s and key = %x does not exist", ns, key)
+	}
+	return value, err
+}
@@ -207,9 +207,10 @@ func (sdb *stateDB) newWorkingSet(ctx context.Context, height uint64) (*workingS
 	}
 
 	return &workingSet{
-		height:    height,
-		finalized: false,
-		dock:      protocol.NewDock(),
+		height:        height,
+		finalized:     false,
+		dock:          protocol.NewDock(),
+		dockSnapshots: make(map[int]protocol.CloneableDock),
 		getStateFunc: func(ns string, key []byte, s interface{}) error {
 			data, err := flusher.KVStoreWithBuffer().Get(ns, key)
 			if err != nil {
@@ -322,7 +323,11 @@ func (sdb *stateDB) NewBlockBuilder(
 			}
 		}
 	}
-	blkBuilder, err := ws.CreateBuilder(ctx, ap, postSystemActions, sdb.cfg.Chain.AllowedBlockGasResidue)
+	strategy, err := NewPackingStrategy(sdb.cfg.Chain.PackingStrategy)
+	if err != nil {
+		return nil, err
+	}
+	blkBuilder, err := ws.CreateBuilder(ctx, ap, postSystemActions, sdb.cfg.Chain.AllowedBlockGasResidue, strategy)
 	if err != nil {
 		return nil, err
 	}
@@ -460,6 +465,11 @@ func (sdb *stateDB) StatesAtHeight(height uint64, opts ...protocol.StateOption)
 	return nil, errors.Wrap(ErrNotSupported, "state db does not support archive mode")
 }
 
+// WorkingSetAtHeight returns a working set atop the confirmed state at height-1 -- archive mode
+func (sdb *stateDB) WorkingSetAtHeight(context.Context, uint64, ...action.SealedEnvelope) (protocol.StateManager, error) {
+	return nil, errors.Wrap(ErrNotSupported, "state db does not support archive mode")
+}
+
 // ReadView reads the view
 func (sdb *stateDB) ReadView(name string) (interface{}, error) {
 	return sdb.protocolView.Read(name)
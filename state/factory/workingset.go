@@ -12,6 +12,7 @@ import (
 
 	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
@@ -20,7 +21,6 @@ import (
 	"github.com/iotexproject/iotex-core/action/protocol"
 	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
 	"github.com/iotexproject/iotex-core/actpool"
-	"github.com/iotexproject/iotex-core/actpool/actioniterator"
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/db"
 	"github.com/iotexproject/iotex-core/pkg/log"
@@ -45,7 +45,8 @@ type (
 	workingSet struct {
 		height        uint64
 		finalized     bool
-		dock          protocol.Dock
+		dock          protocol.CloneableDock
+		dockSnapshots map[int]protocol.CloneableDock
 		receipts      []*action.Receipt
 		commitFunc    func(uint64) error
 		readviewFunc  func(name string) (interface{}, error)
@@ -186,12 +187,25 @@ func (ws *workingSet) finalize() error {
 	return nil
 }
 
+// Snapshot takes a snapshot of the states as well as the protocol dock
 func (ws *workingSet) Snapshot() int {
-	return ws.snapshotFunc()
+	snapshot := ws.snapshotFunc()
+	ws.dockSnapshots[snapshot] = ws.dock.Clone()
+	return snapshot
 }
 
+// Revert reverts the states and the protocol dock to the given snapshot
 func (ws *workingSet) Revert(snapshot int) error {
-	return ws.revertFunc(snapshot)
+	if err := ws.revertFunc(snapshot); err != nil {
+		return err
+	}
+	dk, ok := ws.dockSnapshots[snapshot]
+	if !ok {
+		return errors.Errorf("failed to get dock for snapshot = %d", snapshot)
+	}
+	// the snapshot may be reverted to more than once
+	ws.dock = dk.Clone()
+	return nil
 }
 
 // Commit persists all changes in RunActions() into the DB
@@ -365,6 +379,7 @@ func (ws *workingSet) pickAndRunActions(
 	ap actpool.ActPool,
 	postSystemActions []action.SealedEnvelope,
 	allowedBlockGasResidue uint64,
+	strategy PackingStrategy,
 ) ([]action.SealedEnvelope, error) {
 	err := ws.validate(ctx)
 	if err != nil {
@@ -385,13 +400,25 @@ func (ws *workingSet) pickAndRunActions(
 	// initial action iterator
 	blkCtx := protocol.MustGetBlockCtx(ctx)
 	if ap != nil {
-		actionIterator := actioniterator.NewActionIterator(ap.PendingActionMap())
+		actionIterator := strategy.ActionIterator(ap, func(selp action.SealedEnvelope) (*action.Receipt, error) {
+			actCtx, err := withActionCtx(ctx, selp)
+			if err != nil {
+				return nil, err
+			}
+			snapshot := ws.Snapshot()
+			receipt, err := ws.runAction(actCtx, selp)
+			if revertErr := ws.Revert(snapshot); revertErr != nil {
+				return nil, revertErr
+			}
+			return receipt, err
+		})
 		for {
 			nextAction, ok := actionIterator.Next()
 			if !ok {
 				break
 			}
 			if nextAction.GasLimit() > blkCtx.GasLimit {
+				packingMtc.WithLabelValues(strategy.Name(), "overBlockGasLimit").Inc()
 				actionIterator.PopAccount()
 				continue
 			}
@@ -410,19 +437,40 @@ func (ws *workingSet) pickAndRunActions(
 					return nil, err
 				}
 				ap.DeleteAction(caller)
+				packingMtc.WithLabelValues(strategy.Name(), "invalid").Inc()
 				actionIterator.PopAccount()
 				continue
 			}
+			var snapshot int
+			if strategy.SkipFailedActions() {
+				snapshot = ws.Snapshot()
+			}
 			receipt, err := ws.runAction(ctx, nextAction)
 			switch errors.Cause(err) {
 			case nil:
 				// do nothing
 			case action.ErrHitGasLimit:
+				packingMtc.WithLabelValues(strategy.Name(), "hitGasLimit").Inc()
 				actionIterator.PopAccount()
 				continue
 			default:
 				return nil, errors.Wrapf(err, "Failed to update state changes for selp %x", nextAction.Hash())
 			}
+			if receipt != nil && receipt.Status != uint64(iotextypes.ReceiptStatus_Success) && strategy.SkipFailedActions() {
+				// Drop the state changes of the failed action, as well as the subsequent actions of the same account
+				if err := ws.Revert(snapshot); err != nil {
+					return nil, errors.Wrapf(err, "failed to revert state changes for selp %x", nextAction.Hash())
+				}
+				// Remove them from pool as well, otherwise the account is blocked in every block until they expire
+				caller, err := address.FromBytes(nextAction.SrcPubkey().Hash())
+				if err != nil {
+					return nil, err
+				}
+				ap.DeleteAction(caller)
+				packingMtc.WithLabelValues(strategy.Name(), "failedExecution").Inc()
+				actionIterator.PopAccount()
+				continue
+			}
 			if receipt != nil {
 				blkCtx.GasLimit -= receipt.GasConsumed
 				ctx = protocol.WithBlockCtx(ctx, blkCtx)
@@ -484,8 +532,9 @@ func (ws *workingSet) CreateBuilder(
 	ap actpool.ActPool,
 	postSystemActions []action.SealedEnvelope,
 	allowedBlockGasResidue uint64,
+	strategy PackingStrategy,
 ) (*block.Builder, error) {
-	actions, err := ws.pickAndRunActions(ctx, ap, postSystemActions, allowedBlockGasResidue)
+	actions, err := ws.pickAndRunActions(ctx, ap, postSystemActions, allowedBlockGasResidue, strategy)
 	if err != nil {
 		return nil, err
 	}
@@ -10,6 +10,7 @@ import (
 	hash "github.com/iotexproject/go-pkgs/hash"
 	address "github.com/iotexproject/iotex-address/address"
 	action "github.com/iotexproject/iotex-core/action"
+	actpool "github.com/iotexproject/iotex-core/actpool"
 	block "github.com/iotexproject/iotex-core/blockchain/block"
 	reflect "reflect"
 )
@@ -51,6 +52,34 @@ func (mr *MockActPoolMockRecorder) Validate(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockActPool)(nil).Validate), arg0, arg1)
 }
 
+// Start mocks base method
+func (m *MockActPool) Start(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Start indicates an expected call of Start
+func (mr *MockActPoolMockRecorder) Start(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockActPool)(nil).Start), arg0)
+}
+
+// Stop mocks base method
+func (m *MockActPool) Stop(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stop indicates an expected call of Stop
+func (mr *MockActPoolMockRecorder) Stop(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockActPool)(nil).Stop), arg0)
+}
+
 // Reset mocks base method
 func (m *MockActPool) Reset() {
 	m.ctrl.T.Helper()
@@ -120,6 +149,21 @@ func (mr *MockActPoolMockRecorder) GetUnconfirmedActs(addr interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnconfirmedActs", reflect.TypeOf((*MockActPool)(nil).GetUnconfirmedActs), addr)
 }
 
+// GetPendingAccount mocks base method
+func (m *MockActPool) GetPendingAccount(addr string) (*actpool.PendingAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingAccount", addr)
+	ret0, _ := ret[0].(*actpool.PendingAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingAccount indicates an expected call of GetPendingAccount
+func (mr *MockActPoolMockRecorder) GetPendingAccount(addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingAccount", reflect.TypeOf((*MockActPool)(nil).GetPendingAccount), addr)
+}
+
 // GetActionByHash mocks base method
 func (m *MockActPool) GetActionByHash(hash hash.Hash256) (action.SealedEnvelope, error) {
 	m.ctrl.T.Helper()
@@ -135,6 +179,21 @@ func (mr *MockActPoolMockRecorder) GetActionByHash(hash interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActionByHash", reflect.TypeOf((*MockActPool)(nil).GetActionByHash), hash)
 }
 
+// ArrivalOrder mocks base method
+func (m *MockActPool) ArrivalOrder(hash hash.Hash256) (uint64, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArrivalOrder", hash)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// ArrivalOrder indicates an expected call of ArrivalOrder
+func (mr *MockActPoolMockRecorder) ArrivalOrder(hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArrivalOrder", reflect.TypeOf((*MockActPool)(nil).ArrivalOrder), hash)
+}
+
 // GetSize mocks base method
 func (m *MockActPool) GetSize() uint64 {
 	m.ctrl.T.Helper()
@@ -217,6 +276,72 @@ func (mr *MockActPoolMockRecorder) ReceiveBlock(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveBlock", reflect.TypeOf((*MockActPool)(nil).ReceiveBlock), arg0)
 }
 
+// AddToBlackList mocks base method
+func (m *MockActPool) AddToBlackList(addr string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddToBlackList", addr)
+}
+
+// AddToBlackList indicates an expected call of AddToBlackList
+func (mr *MockActPoolMockRecorder) AddToBlackList(addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToBlackList", reflect.TypeOf((*MockActPool)(nil).AddToBlackList), addr)
+}
+
+// RemoveFromBlackList mocks base method
+func (m *MockActPool) RemoveFromBlackList(addr string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveFromBlackList", addr)
+}
+
+// RemoveFromBlackList indicates an expected call of RemoveFromBlackList
+func (mr *MockActPoolMockRecorder) RemoveFromBlackList(addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromBlackList", reflect.TypeOf((*MockActPool)(nil).RemoveFromBlackList), addr)
+}
+
+// BlackList mocks base method
+func (m *MockActPool) BlackList() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlackList")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// BlackList indicates an expected call of BlackList
+func (mr *MockActPoolMockRecorder) BlackList() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlackList", reflect.TypeOf((*MockActPool)(nil).BlackList))
+}
+
+// AddActionEventSubscriber mocks base method
+func (m *MockActPool) AddActionEventSubscriber(arg0 actpool.ActionEventSubscriber) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddActionEventSubscriber", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddActionEventSubscriber indicates an expected call of AddActionEventSubscriber
+func (mr *MockActPoolMockRecorder) AddActionEventSubscriber(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddActionEventSubscriber", reflect.TypeOf((*MockActPool)(nil).AddActionEventSubscriber), arg0)
+}
+
+// RemoveActionEventSubscriber mocks base method
+func (m *MockActPool) RemoveActionEventSubscriber(arg0 actpool.ActionEventSubscriber) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveActionEventSubscriber", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveActionEventSubscriber indicates an expected call of RemoveActionEventSubscriber
+func (mr *MockActPoolMockRecorder) RemoveActionEventSubscriber(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveActionEventSubscriber", reflect.TypeOf((*MockActPool)(nil).RemoveActionEventSubscriber), arg0)
+}
+
 // AddActionEnvelopeValidators mocks base method
 func (m *MockActPool) AddActionEnvelopeValidators(arg0 ...action.SealedEnvelopeValidator) {
 	m.ctrl.T.Helper()
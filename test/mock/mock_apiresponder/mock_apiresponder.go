@@ -6,6 +6,7 @@ package mock_apiresponder
 
 import (
 	gomock "github.com/golang/mock/gomock"
+	actpool "github.com/iotexproject/iotex-core/actpool"
 	block "github.com/iotexproject/iotex-core/blockchain/block"
 	reflect "reflect"
 )
@@ -58,3 +59,115 @@ func (mr *MockResponderMockRecorder) Exit() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exit", reflect.TypeOf((*MockResponder)(nil).Exit))
 }
+
+// MockRevertResponder is a mock of RevertResponder interface
+type MockRevertResponder struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevertResponderMockRecorder
+}
+
+// MockRevertResponderMockRecorder is the mock recorder for MockRevertResponder
+type MockRevertResponderMockRecorder struct {
+	mock *MockRevertResponder
+}
+
+// NewMockRevertResponder creates a new mock instance
+func NewMockRevertResponder(ctrl *gomock.Controller) *MockRevertResponder {
+	mock := &MockRevertResponder{ctrl: ctrl}
+	mock.recorder = &MockRevertResponderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRevertResponder) EXPECT() *MockRevertResponderMockRecorder {
+	return m.recorder
+}
+
+// Respond mocks base method
+func (m *MockRevertResponder) Respond(arg0 *block.Block) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Respond", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Respond indicates an expected call of Respond
+func (mr *MockRevertResponderMockRecorder) Respond(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Respond", reflect.TypeOf((*MockRevertResponder)(nil).Respond), arg0)
+}
+
+// Exit mocks base method
+func (m *MockRevertResponder) Exit() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Exit")
+}
+
+// Exit indicates an expected call of Exit
+func (mr *MockRevertResponderMockRecorder) Exit() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exit", reflect.TypeOf((*MockRevertResponder)(nil).Exit))
+}
+
+// RespondRevert mocks base method
+func (m *MockRevertResponder) RespondRevert(arg0 *block.Block) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RespondRevert", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RespondRevert indicates an expected call of RespondRevert
+func (mr *MockRevertResponderMockRecorder) RespondRevert(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondRevert", reflect.TypeOf((*MockRevertResponder)(nil).RespondRevert), arg0)
+}
+
+// MockActionResponder is a mock of ActionResponder interface
+type MockActionResponder struct {
+	ctrl     *gomock.Controller
+	recorder *MockActionResponderMockRecorder
+}
+
+// MockActionResponderMockRecorder is the mock recorder for MockActionResponder
+type MockActionResponderMockRecorder struct {
+	mock *MockActionResponder
+}
+
+// NewMockActionResponder creates a new mock instance
+func NewMockActionResponder(ctrl *gomock.Controller) *MockActionResponder {
+	mock := &MockActionResponder{ctrl: ctrl}
+	mock.recorder = &MockActionResponderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockActionResponder) EXPECT() *MockActionResponderMockRecorder {
+	return m.recorder
+}
+
+// Respond mocks base method
+func (m *MockActionResponder) Respond(arg0 *actpool.ActionEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Respond", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Respond indicates an expected call of Respond
+func (mr *MockActionResponderMockRecorder) Respond(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Respond", reflect.TypeOf((*MockActionResponder)(nil).Respond), arg0)
+}
+
+// Exit mocks base method
+func (m *MockActionResponder) Exit() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Exit")
+}
+
+// Exit indicates an expected call of Exit
+func (mr *MockActionResponderMockRecorder) Exit() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exit", reflect.TypeOf((*MockActionResponder)(nil).Exit))
+}
@@ -7,6 +7,7 @@ package mock_apiserver
 import (
 	context "context"
 	gomock "github.com/golang/mock/gomock"
+	apipb "github.com/iotexproject/iotex-core/api/apipb"
 	iotexapi "github.com/iotexproject/iotex-proto/golang/iotexapi"
 	metadata "google.golang.org/grpc/metadata"
 	reflect "reflect"
@@ -130,3 +131,241 @@ func (mr *MockStreamBlocksServerMockRecorder) RecvMsg(m interface{}) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockStreamBlocksServer)(nil).RecvMsg), m)
 }
+
+// MockStreamPendingActionsServer is a mock of StreamPendingActionsServer interface
+type MockStreamPendingActionsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockStreamPendingActionsServerMockRecorder
+}
+
+// MockStreamPendingActionsServerMockRecorder is the mock recorder for MockStreamPendingActionsServer
+type MockStreamPendingActionsServerMockRecorder struct {
+	mock *MockStreamPendingActionsServer
+}
+
+// NewMockStreamPendingActionsServer creates a new mock instance
+func NewMockStreamPendingActionsServer(ctrl *gomock.Controller) *MockStreamPendingActionsServer {
+	mock := &MockStreamPendingActionsServer{ctrl: ctrl}
+	mock.recorder = &MockStreamPendingActionsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockStreamPendingActionsServer) EXPECT() *MockStreamPendingActionsServerMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method
+func (m *MockStreamPendingActionsServer) Send(arg0 *apipb.StreamPendingActionsResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send
+func (mr *MockStreamPendingActionsServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockStreamPendingActionsServer)(nil).Send), arg0)
+}
+
+// SetHeader mocks base method
+func (m *MockStreamPendingActionsServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader
+func (mr *MockStreamPendingActionsServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockStreamPendingActionsServer)(nil).SetHeader), arg0)
+}
+
+// SendHeader mocks base method
+func (m *MockStreamPendingActionsServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader
+func (mr *MockStreamPendingActionsServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockStreamPendingActionsServer)(nil).SendHeader), arg0)
+}
+
+// SetTrailer mocks base method
+func (m *MockStreamPendingActionsServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer
+func (mr *MockStreamPendingActionsServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockStreamPendingActionsServer)(nil).SetTrailer), arg0)
+}
+
+// Context mocks base method
+func (m *MockStreamPendingActionsServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockStreamPendingActionsServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockStreamPendingActionsServer)(nil).Context))
+}
+
+// SendMsg mocks base method
+func (m_2 *MockStreamPendingActionsServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockStreamPendingActionsServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockStreamPendingActionsServer)(nil).SendMsg), m)
+}
+
+// RecvMsg mocks base method
+func (m_2 *MockStreamPendingActionsServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockStreamPendingActionsServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockStreamPendingActionsServer)(nil).RecvMsg), m)
+}
+
+// MockStreamBlockEventsServer is a mock of StreamBlockEventsServer interface
+type MockStreamBlockEventsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockStreamBlockEventsServerMockRecorder
+}
+
+// MockStreamBlockEventsServerMockRecorder is the mock recorder for MockStreamBlockEventsServer
+type MockStreamBlockEventsServerMockRecorder struct {
+	mock *MockStreamBlockEventsServer
+}
+
+// NewMockStreamBlockEventsServer creates a new mock instance
+func NewMockStreamBlockEventsServer(ctrl *gomock.Controller) *MockStreamBlockEventsServer {
+	mock := &MockStreamBlockEventsServer{ctrl: ctrl}
+	mock.recorder = &MockStreamBlockEventsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockStreamBlockEventsServer) EXPECT() *MockStreamBlockEventsServerMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method
+func (m *MockStreamBlockEventsServer) Send(arg0 *apipb.StreamBlockEventsResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send
+func (mr *MockStreamBlockEventsServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockStreamBlockEventsServer)(nil).Send), arg0)
+}
+
+// SetHeader mocks base method
+func (m *MockStreamBlockEventsServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader
+func (mr *MockStreamBlockEventsServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockStreamBlockEventsServer)(nil).SetHeader), arg0)
+}
+
+// SendHeader mocks base method
+func (m *MockStreamBlockEventsServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader
+func (mr *MockStreamBlockEventsServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockStreamBlockEventsServer)(nil).SendHeader), arg0)
+}
+
+// SetTrailer mocks base method
+func (m *MockStreamBlockEventsServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer
+func (mr *MockStreamBlockEventsServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockStreamBlockEventsServer)(nil).SetTrailer), arg0)
+}
+
+// Context mocks base method
+func (m *MockStreamBlockEventsServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockStreamBlockEventsServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockStreamBlockEventsServer)(nil).Context))
+}
+
+// SendMsg mocks base method
+func (m_2 *MockStreamBlockEventsServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockStreamBlockEventsServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockStreamBlockEventsServer)(nil).SendMsg), m)
+}
+
+// RecvMsg mocks base method
+func (m_2 *MockStreamBlockEventsServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockStreamBlockEventsServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockStreamBlockEventsServer)(nil).RecvMsg), m)
+}
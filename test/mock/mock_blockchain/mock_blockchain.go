@@ -225,6 +225,20 @@ func (mr *MockBlockchainMockRecorder) ValidateBlock(blk interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateBlock", reflect.TypeOf((*MockBlockchain)(nil).ValidateBlock), blk)
 }
 
+// DeleteBlockToTarget mocks base method
+func (m *MockBlockchain) DeleteBlockToTarget(targetHeight uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBlockToTarget", targetHeight)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBlockToTarget indicates an expected call of DeleteBlockToTarget
+func (mr *MockBlockchainMockRecorder) DeleteBlockToTarget(targetHeight interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBlockToTarget", reflect.TypeOf((*MockBlockchain)(nil).DeleteBlockToTarget), targetHeight)
+}
+
 // AddSubscriber mocks base method
 func (m *MockBlockchain) AddSubscriber(arg0 blockchain.BlockCreationSubscriber) error {
 	m.ctrl.T.Helper()
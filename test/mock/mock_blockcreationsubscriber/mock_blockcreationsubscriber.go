@@ -46,3 +46,54 @@ func (mr *MockBlockCreationSubscriberMockRecorder) ReceiveBlock(arg0 interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveBlock", reflect.TypeOf((*MockBlockCreationSubscriber)(nil).ReceiveBlock), arg0)
 }
+
+// MockBlockRevertSubscriber is a mock of BlockRevertSubscriber interface
+type MockBlockRevertSubscriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlockRevertSubscriberMockRecorder
+}
+
+// MockBlockRevertSubscriberMockRecorder is the mock recorder for MockBlockRevertSubscriber
+type MockBlockRevertSubscriberMockRecorder struct {
+	mock *MockBlockRevertSubscriber
+}
+
+// NewMockBlockRevertSubscriber creates a new mock instance
+func NewMockBlockRevertSubscriber(ctrl *gomock.Controller) *MockBlockRevertSubscriber {
+	mock := &MockBlockRevertSubscriber{ctrl: ctrl}
+	mock.recorder = &MockBlockRevertSubscriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBlockRevertSubscriber) EXPECT() *MockBlockRevertSubscriberMockRecorder {
+	return m.recorder
+}
+
+// ReceiveBlock mocks base method
+func (m *MockBlockRevertSubscriber) ReceiveBlock(arg0 *block.Block) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReceiveBlock", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReceiveBlock indicates an expected call of ReceiveBlock
+func (mr *MockBlockRevertSubscriberMockRecorder) ReceiveBlock(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveBlock", reflect.TypeOf((*MockBlockRevertSubscriber)(nil).ReceiveBlock), arg0)
+}
+
+// RevertBlock mocks base method
+func (m *MockBlockRevertSubscriber) RevertBlock(arg0 *block.Block) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertBlock", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevertBlock indicates an expected call of RevertBlock
+func (mr *MockBlockRevertSubscriberMockRecorder) RevertBlock(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertBlock", reflect.TypeOf((*MockBlockRevertSubscriber)(nil).RevertBlock), arg0)
+}
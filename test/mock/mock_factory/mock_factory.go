@@ -14,6 +14,7 @@ import (
 	actpool "github.com/iotexproject/iotex-core/actpool"
 	block "github.com/iotexproject/iotex-core/blockchain/block"
 	state "github.com/iotexproject/iotex-core/state"
+	factory "github.com/iotexproject/iotex-core/state/factory"
 	reflect "reflect"
 )
 
@@ -263,3 +264,43 @@ func (mr *MockFactoryMockRecorder) StatesAtHeight(arg0 interface{}, arg1 ...inte
 	varargs := append([]interface{}{arg0}, arg1...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatesAtHeight", reflect.TypeOf((*MockFactory)(nil).StatesAtHeight), varargs...)
 }
+
+// WorkingSetAtHeight mocks base method
+func (m *MockFactory) WorkingSetAtHeight(arg0 context.Context, arg1 uint64, arg2 ...action.SealedEnvelope) (protocol.StateManager, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WorkingSetAtHeight", varargs...)
+	ret0, _ := ret[0].(protocol.StateManager)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WorkingSetAtHeight indicates an expected call of WorkingSetAtHeight
+func (mr *MockFactoryMockRecorder) WorkingSetAtHeight(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkingSetAtHeight", reflect.TypeOf((*MockFactory)(nil).WorkingSetAtHeight), varargs...)
+}
+
+// StateProofAtHeight mocks base method
+func (m *MockFactory) StateProofAtHeight(arg0 uint64, arg1 ...protocol.StateOption) (*factory.StateProof, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StateProofAtHeight", varargs...)
+	ret0, _ := ret[0].(*factory.StateProof)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateProofAtHeight indicates an expected call of StateProofAtHeight
+func (mr *MockFactoryMockRecorder) StateProofAtHeight(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateProofAtHeight", reflect.TypeOf((*MockFactory)(nil).StateProofAtHeight), varargs...)
+}
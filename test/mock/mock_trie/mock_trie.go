@@ -129,6 +129,21 @@ func (mr *MockTrieMockRecorder) Get(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTrie)(nil).Get), arg0)
 }
 
+// GetProof mocks base method
+func (m *MockTrie) GetProof(arg0 []byte) ([][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProof", arg0)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProof indicates an expected call of GetProof
+func (mr *MockTrieMockRecorder) GetProof(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProof", reflect.TypeOf((*MockTrie)(nil).GetProof), arg0)
+}
+
 // Delete mocks base method
 func (m *MockTrie) Delete(arg0 []byte) error {
 	m.ctrl.T.Helper()
@@ -281,6 +296,22 @@ func (mr *MockTwoLayerTrieMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTwoLayerTrie)(nil).Get), arg0, arg1)
 }
 
+// GetProof mocks base method
+func (m *MockTwoLayerTrie) GetProof(arg0, arg1 []byte) ([][]byte, [][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProof", arg0, arg1)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].([][]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetProof indicates an expected call of GetProof
+func (mr *MockTwoLayerTrieMockRecorder) GetProof(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProof", reflect.TypeOf((*MockTwoLayerTrie)(nil).GetProof), arg0, arg1)
+}
+
 // Upsert mocks base method
 func (m *MockTwoLayerTrie) Upsert(arg0, arg1, arg2 []byte) error {
 	m.ctrl.T.Helper()
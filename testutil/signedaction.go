@@ -7,9 +7,14 @@
 package testutil
 
 import (
+	"crypto/ecdsa"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/iotex-address/address"
 	"github.com/pkg/errors"
 
 	"github.com/iotexproject/iotex-core/action"
@@ -51,6 +56,30 @@ func SignedExecution(contractAddr string, executorPriKey crypto.PrivateKey, nonc
 	return selp, nil
 }
 
+// SignedRawTx returns an RLP-encoded Ethereum transaction signed per EIP-155, it creates a contract if the
+// recipient is empty
+func SignedRawTx(recipientAddr string, senderPriKey crypto.PrivateKey, nonce uint64, amount *big.Int, gasLimit uint64, gasPrice *big.Int, data []byte, evmNetworkID uint32) ([]byte, error) {
+	var tx *types.Transaction
+	if recipientAddr == "" {
+		tx = types.NewContractCreation(nonce, amount, gasLimit, gasPrice, data)
+	} else {
+		addr, err := address.FromString(recipientAddr)
+		if err != nil {
+			return nil, err
+		}
+		tx = types.NewTransaction(nonce, common.BytesToAddress(addr.Bytes()), amount, gasLimit, gasPrice, data)
+	}
+	sk, ok := senderPriKey.EcdsaPrivateKey().(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("only secp256k1 private key can sign Ethereum transaction")
+	}
+	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(new(big.Int).SetUint64(uint64(evmNetworkID))), sk)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign raw transaction")
+	}
+	return rlp.EncodeToBytes(signedTx)
+}
+
 // SignedCandidateRegister returns a signed candidate register
 func SignedCandidateRegister(
 	nonce uint64,